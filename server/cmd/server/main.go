@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/ali-nur31/mile-do/config"
 	_ "github.com/ali-nur31/mile-do/docs"
+	"github.com/ali-nur31/mile-do/internal/domain"
 	"github.com/ali-nur31/mile-do/internal/jobs"
 	"github.com/ali-nur31/mile-do/internal/jobs/workers"
 	"github.com/ali-nur31/mile-do/internal/repository/db"
@@ -19,9 +21,13 @@ import (
 	v1 "github.com/ali-nur31/mile-do/internal/transport/http/v1"
 	"github.com/ali-nur31/mile-do/pkg/asynq_jobs"
 	"github.com/ali-nur31/mile-do/pkg/auth"
+	"github.com/ali-nur31/mile-do/pkg/blobstore"
 	"github.com/ali-nur31/mile-do/pkg/logger"
+	"github.com/ali-nur31/mile-do/pkg/mailer"
+	"github.com/ali-nur31/mile-do/pkg/notifier"
 	"github.com/ali-nur31/mile-do/pkg/postgres"
 	"github.com/ali-nur31/mile-do/pkg/redis_db"
+	"github.com/ali-nur31/mile-do/pkg/webhook"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	echo_middleware "github.com/labstack/echo/v4/middleware"
@@ -77,6 +83,7 @@ func main() {
 	}
 
 	defer asynq.Client.Close()
+	defer asynq.Inspector.Close()
 
 	redisRepo := redis2.NewAuthRedisRepo(redisClient.Rdb)
 
@@ -94,17 +101,96 @@ func main() {
 	userService := service.NewUserService(queries, passwordManager)
 	userHandler := v1.NewUserHandler(userService)
 
-	goalService := service.NewGoalService(queries)
+	webhookService := service.NewWebhookService(queries, asynq.Client, webhook.NewHttpSender())
+	webhookHandler := v1.NewWebhookHandler(webhookService)
+
+	goalService := service.NewGoalService(queries, webhookService)
 	goalHandler := v1.NewGoalHandler(goalService)
 
 	authService := service.NewAuthService(queries, redisRepo, asynq.Client, pg.Pool, userService, goalService, jwtTokenManager, refreshTokenService, passwordManager)
 	authHandler := v1.NewAuthHandler(authService)
 
-	recurringTasksTemplateService := service.NewRecurringTasksTemplateService(queries, asynq.Client)
-	recurringTasksTemplateHandler := v1.NewRecurringTasksTemplateHandler(recurringTasksTemplateService)
+	tagService := service.NewTagService(queries)
+	tagHandler := v1.NewTagHandler(tagService)
+
+	notificationService := service.NewNotificationService(queries)
+	notificationHandler := v1.NewNotificationHandler(notificationService)
+
+	notifiers := []domain.Notifier{notifier.NewWebhookNotifier(webhookService)}
+
+	var emailMailer domain.Mailer
+	if cfg.Smtp.Host != "" {
+		smtpMailer, err := mailer.NewSmtpMailer(&cfg.Smtp)
+		if err != nil {
+			slog.Error("couldn't initialize smtp mailer", "error", err)
+			os.Exit(1)
+		}
+
+		emailMailer = smtpMailer
+		notifiers = append(notifiers, notifier.NewEmailNotifier(emailMailer, userService))
+	}
+
+	agendaService := service.NewAgendaService(queries, emailMailer)
+
+	goalMemberService := service.NewGoalMemberService(queries, pg.Pool, userService, emailMailer)
+	goalMemberHandler := v1.NewGoalMemberHandler(goalMemberService)
+
+	reminderService := service.NewReminderService(queries, asynq.Client, asynq.Inspector, userService, notifier.NewMultiNotifier(notificationService, notifiers...))
+
+	recurringTasksTemplateService := service.NewRecurringTasksTemplateService(queries, pg.Pool, asynq.Client, tagService, reminderService)
+	recurringTasksTemplateHandler := v1.NewRecurringTasksTemplateHandler(recurringTasksTemplateService, goalMemberService)
+
+	taskChecklistItemService := service.NewTaskChecklistItemService(queries)
+
+	taskService := service.NewTaskService(queries, pg.Pool, userService, recurringTasksTemplateService, taskChecklistItemService, tagService, reminderService, webhookService)
+	taskHandler := v1.NewTaskHandler(taskService, goalService, recurringTasksTemplateService, goalMemberService)
+
+	taskChecklistItemHandler := v1.NewTaskChecklistItemHandler(taskChecklistItemService, taskService, goalMemberService)
+
+	taskCommentService := service.NewTaskCommentService(queries)
+	taskCommentHandler := v1.NewTaskCommentHandler(taskCommentService, taskService)
+
+	taskDependencyService := service.NewTaskDependencyService(queries, pg.Pool)
+	taskDependencyHandler := v1.NewTaskDependencyHandler(taskDependencyService, taskService, goalMemberService)
+
+	var blobStore domain.BlobStore
+	switch cfg.Attachments.Storage {
+	case "local":
+		blobStore, err = blobstore.NewLocalStore(cfg.Attachments.Dir)
+	case "s3":
+		blobStore, err = blobstore.NewS3Store(ctx, &cfg.S3)
+	default:
+		err = fmt.Errorf("unknown attachments storage %q", cfg.Attachments.Storage)
+	}
+	if err != nil {
+		slog.Error("couldn't initialize attachments storage", "error", err)
+		os.Exit(1)
+	}
+
+	attachmentMaxSizeBytes := cfg.Attachments.MaxSizeMb << 20
+
+	taskAttachmentService := service.NewTaskAttachmentService(queries, blobStore, attachmentMaxSizeBytes, cfg.Attachments.MaxPerTask)
+	taskAttachmentHandler := v1.NewTaskAttachmentHandler(taskAttachmentService, taskService, goalMemberService, attachmentMaxSizeBytes)
+
+	reminderHandler := v1.NewReminderHandler(reminderService, taskService, recurringTasksTemplateService)
+
+	calendarService := service.NewCalendarService(userService, taskService, recurringTasksTemplateService)
+	calendarHandler := v1.NewCalendarHandler(calendarService)
+
+	importService := service.NewImportService(queries, pg.Pool, asynq.Client, userService, goalService, taskService, recurringTasksTemplateService)
+	importHandler := v1.NewImportHandler(importService, goalService)
+
+	exportService := service.NewExportService(queries, asynq.Client, cfg.Export.Dir, userService, goalService, taskService, recurringTasksTemplateService)
+	exportHandler := v1.NewExportHandler(exportService)
+
+	analyticsService := service.NewAnalyticsService(queries, userService)
+	analyticsHandler := v1.NewAnalyticsHandler(analyticsService)
 
-	taskService := service.NewTaskService(queries, pg.Pool, recurringTasksTemplateService)
-	taskHandler := v1.NewTaskHandler(taskService)
+	habitService := service.NewHabitService(queries, userService, taskService, recurringTasksTemplateService)
+	habitHandler := v1.NewHabitHandler(habitService, recurringTasksTemplateService, goalMemberService)
+
+	weeklyReviewService := service.NewWeeklyReviewService(queries, userService)
+	weeklyReviewHandler := v1.NewWeeklyReviewHandler(weeklyReviewService)
 
 	router := v1.NewRouter(
 		cfg.Redis,
@@ -112,8 +198,23 @@ func main() {
 		*authHandler,
 		*userHandler,
 		*goalHandler,
+		*goalMemberHandler,
 		*recurringTasksTemplateHandler,
 		*taskHandler,
+		*taskChecklistItemHandler,
+		*taskCommentHandler,
+		*taskAttachmentHandler,
+		*taskDependencyHandler,
+		*tagHandler,
+		*calendarHandler,
+		*importHandler,
+		*exportHandler,
+		*analyticsHandler,
+		*habitHandler,
+		*weeklyReviewHandler,
+		*reminderHandler,
+		*notificationHandler,
+		*webhookHandler,
 	)
 
 	e := echo.New()
@@ -140,7 +241,23 @@ func main() {
 
 	recurringTasksTemplatesWorker := workers.NewRecurringTasksTemplatesWorker(pg.Pool, taskService)
 
-	backgroundWorker := jobs.NewJobRouter(&cfg.Redis, recurringTasksTemplatesWorker)
+	tasksWorker := workers.NewTasksWorker(taskService)
+
+	importsWorker := workers.NewImportsWorker(importService)
+
+	exportsWorker := workers.NewExportsWorker(exportService)
+
+	weeklyReviewsWorker := workers.NewWeeklyReviewsWorker(weeklyReviewService)
+
+	remindersWorker := workers.NewRemindersWorker(reminderService)
+
+	agendasWorker := workers.NewAgendasWorker(agendaService)
+
+	webhooksWorker := workers.NewWebhooksWorker(webhookService)
+
+	attachmentsWorker := workers.NewAttachmentsWorker(taskAttachmentService)
+
+	backgroundWorker := jobs.NewJobRouter(&cfg.Redis, recurringTasksTemplatesWorker, tasksWorker, importsWorker, exportsWorker, weeklyReviewsWorker, remindersWorker, agendasWorker, webhooksWorker, attachmentsWorker)
 
 	go func() {
 		if err = backgroundWorker.Run(); err != nil {
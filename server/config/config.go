@@ -7,10 +7,14 @@ import (
 )
 
 type Config struct {
-	DB    Database
-	Redis Redis
-	Api   Api
-	Jwt   Jwt
+	DB          Database
+	Redis       Redis
+	Api         Api
+	Jwt         Jwt
+	Export      Export
+	Smtp        Smtp
+	Attachments Attachments
+	S3          S3
 }
 
 type Api struct {
@@ -25,6 +29,36 @@ type Jwt struct {
 	RefreshExpDays int    `env:"JWT_REFRESH_EXP_DAYS" env-default:"7"`
 }
 
+type Export struct {
+	Dir string `env:"EXPORT_DIR" env-default:"./exports"`
+}
+
+type Smtp struct {
+	Host     string `env:"SMTP_HOST" env-default:""`
+	Port     string `env:"SMTP_PORT" env-default:"587"`
+	Username string `env:"SMTP_USERNAME" env-default:""`
+	Password string `env:"SMTP_PASSWORD" env-default:""`
+	From     string `env:"SMTP_FROM" env-default:"Mile-Do <no-reply@mile-do.local>"`
+}
+
+// Attachments picks blob storage of task attachments, "local" keeps files in Dir and "s3" in bucket of S3.
+type Attachments struct {
+	Storage    string `env:"ATTACHMENTS_STORAGE" env-default:"local"`
+	Dir        string `env:"ATTACHMENTS_DIR" env-default:"./attachments"`
+	MaxSizeMb  int64  `env:"ATTACHMENTS_MAX_SIZE_MB" env-default:"10"`
+	MaxPerTask int    `env:"ATTACHMENTS_MAX_PER_TASK" env-default:"20"`
+}
+
+// S3 is any S3-compatible storage, e.g. AWS S3 or MinIO.
+type S3 struct {
+	Endpoint  string `env:"S3_ENDPOINT" env-default:"localhost:9000"`
+	Region    string `env:"S3_REGION" env-default:"us-east-1"`
+	Bucket    string `env:"S3_BUCKET" env-default:"mile-do-attachments"`
+	AccessKey string `env:"S3_ACCESS_KEY" env-default:""`
+	SecretKey string `env:"S3_SECRET_KEY" env-default:""`
+	UseSsl    bool   `env:"S3_USE_SSL" env-default:"false"`
+}
+
 type Database struct {
 	Port     string `env:"DB_PORT" env-default:"5432"`
 	Host     string `env:"DB_HOST" env-default:"localhost"`
@@ -47,11 +81,19 @@ func MustLoad() *Config {
 	rdb := redisLoad()
 	api := apiLoad()
 	jwt := jwtLoad()
+	export := exportLoad()
+	smtp := smtpLoad()
+	attachments := attachmentsLoad()
+	s3 := s3Load()
 
 	cfg.DB = db
 	cfg.Redis = rdb
 	cfg.Api = api
 	cfg.Jwt = jwt
+	cfg.Export = export
+	cfg.Smtp = smtp
+	cfg.Attachments = attachments
+	cfg.S3 = s3
 
 	return &cfg
 }
@@ -78,6 +120,50 @@ func jwtLoad() Jwt {
 	return jwt
 }
 
+func exportLoad() Export {
+	var export Export
+
+	err := cleanenv.ReadEnv(&export)
+	if err != nil {
+		slog.Error("failed to load .env vars for export, using default values", "error", err)
+	}
+
+	return export
+}
+
+func smtpLoad() Smtp {
+	var smtp Smtp
+
+	err := cleanenv.ReadEnv(&smtp)
+	if err != nil {
+		slog.Error("failed to load .env vars for SMTP, using default values", "error", err)
+	}
+
+	return smtp
+}
+
+func attachmentsLoad() Attachments {
+	var attachments Attachments
+
+	err := cleanenv.ReadEnv(&attachments)
+	if err != nil {
+		slog.Error("failed to load .env vars for attachments, using default values", "error", err)
+	}
+
+	return attachments
+}
+
+func s3Load() S3 {
+	var s3 S3
+
+	err := cleanenv.ReadEnv(&s3)
+	if err != nil {
+		slog.Error("failed to load .env vars for S3, using default values", "error", err)
+	}
+
+	return s3
+}
+
 func databaseLoad() Database {
 	var db Database
 
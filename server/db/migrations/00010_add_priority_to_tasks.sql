@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE task_priority AS ENUM ('none', 'low', 'medium', 'high');
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS priority task_priority NOT NULL DEFAULT 'none';
+ALTER TABLE recurring_tasks_templates ADD COLUMN IF NOT EXISTS priority task_priority NOT NULL DEFAULT 'none';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE recurring_tasks_templates DROP COLUMN IF EXISTS priority;
+ALTER TABLE tasks DROP COLUMN IF EXISTS priority;
+
+DROP TYPE IF EXISTS task_priority;
+-- +goose StatementEnd
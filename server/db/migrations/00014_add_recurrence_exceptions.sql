@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE recurring_tasks_templates ADD COLUMN IF NOT EXISTS exdates DATE[] NOT NULL DEFAULT '{}';
+ALTER TABLE recurring_tasks_templates ADD COLUMN IF NOT EXISTS rdates DATE[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS is_recurrence_exception BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE tasks DROP COLUMN IF EXISTS is_recurrence_exception;
+
+ALTER TABLE recurring_tasks_templates DROP COLUMN IF EXISTS rdates;
+ALTER TABLE recurring_tasks_templates DROP COLUMN IF EXISTS exdates;
+-- +goose StatementEnd
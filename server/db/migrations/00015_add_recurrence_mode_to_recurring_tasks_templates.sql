@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE recurrence_mode AS ENUM ('calendar', 'after_completion');
+
+ALTER TABLE recurring_tasks_templates ADD COLUMN IF NOT EXISTS recurrence_mode recurrence_mode NOT NULL DEFAULT 'calendar';
+ALTER TABLE recurring_tasks_templates ADD COLUMN IF NOT EXISTS recurrence_interval_days INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE recurring_tasks_templates DROP COLUMN IF EXISTS recurrence_interval_days;
+ALTER TABLE recurring_tasks_templates DROP COLUMN IF EXISTS recurrence_mode;
+
+DROP TYPE IF EXISTS recurrence_mode;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS calendar_token_hash TEXT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_calendar_token_hash ON users (calendar_token_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_calendar_token_hash;
+ALTER TABLE users DROP COLUMN IF EXISTS calendar_token_hash;
+-- +goose StatementEnd
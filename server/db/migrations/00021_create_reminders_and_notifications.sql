@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminders (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    task_id INT NULL,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    recurring_template_id INT NULL,
+    FOREIGN KEY (recurring_template_id) REFERENCES recurring_tasks_templates(id) ON DELETE CASCADE,
+    offset_minutes INT NOT NULL CHECK (offset_minutes >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CHECK ((task_id IS NULL) <> (recurring_template_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminders_task ON reminders(task_id);
+CREATE INDEX IF NOT EXISTS idx_reminders_recurring_template ON reminders(recurring_template_id);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    task_id INT NULL,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    read_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user ON notifications(user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_notifications_user;
+DROP TABLE IF EXISTS notifications;
+
+DROP INDEX IF EXISTS idx_reminders_recurring_template;
+DROP INDEX IF EXISTS idx_reminders_task;
+DROP TABLE IF EXISTS reminders;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE goal_member_role AS ENUM ('owner', 'editor', 'viewer');
+
+CREATE TABLE IF NOT EXISTS goal_members (
+    goal_id INT NOT NULL,
+    FOREIGN KEY (goal_id) REFERENCES goals(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    role goal_member_role NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (goal_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_goal_members_user ON goal_members(user_id);
+
+INSERT INTO goal_members (goal_id, user_id, role)
+SELECT id, user_id, 'owner' FROM goals;
+
+CREATE TABLE IF NOT EXISTS goal_invitations (
+    id BIGSERIAL PRIMARY KEY,
+    goal_id INT NOT NULL,
+    FOREIGN KEY (goal_id) REFERENCES goals(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    role goal_member_role NOT NULL,
+    invited_by INT NOT NULL,
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    UNIQUE (goal_id, email)
+);
+
+CREATE INDEX IF NOT EXISTS idx_goal_invitations_email ON goal_invitations(lower(email));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_goal_invitations_email;
+DROP TABLE IF EXISTS goal_invitations;
+
+DROP INDEX IF EXISTS idx_goal_members_user;
+DROP TABLE IF EXISTS goal_members;
+DROP TYPE IF EXISTS goal_member_role;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS assignee_id INT NULL REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee ON tasks(assignee_id) WHERE assignee_id IS NOT NULL;
+
+ALTER TABLE recurring_tasks_templates ADD COLUMN IF NOT EXISTS assignee_ids INT[] NOT NULL DEFAULT '{}';
+ALTER TABLE recurring_tasks_templates ADD COLUMN IF NOT EXISTS assignee_rotation INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE recurring_tasks_templates DROP COLUMN IF EXISTS assignee_rotation;
+ALTER TABLE recurring_tasks_templates DROP COLUMN IF EXISTS assignee_ids;
+
+DROP INDEX IF EXISTS idx_tasks_assignee;
+ALTER TABLE tasks DROP COLUMN IF EXISTS assignee_id;
+-- +goose StatementEnd
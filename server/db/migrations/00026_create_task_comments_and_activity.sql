@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS task_comments (
+    id BIGSERIAL PRIMARY KEY,
+    task_id INT NOT NULL,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_comments_task ON task_comments(task_id, created_at);
+
+CREATE TYPE task_activity_action AS ENUM ('created', 'updated', 'rescheduled', 'completed', 'uncompleted', 'deleted');
+
+-- task_id has no foreign key, history of a task outlives the task itself
+CREATE TABLE IF NOT EXISTS task_activity (
+    id BIGSERIAL PRIMARY KEY,
+    task_id INT NOT NULL,
+    action task_activity_action NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_activity_task ON task_activity(task_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_task_activity_task;
+DROP TABLE IF EXISTS task_activity;
+DROP TYPE IF EXISTS task_activity_action;
+
+DROP INDEX IF EXISTS idx_task_comments_task;
+DROP TABLE IF EXISTS task_comments;
+-- +goose StatementEnd
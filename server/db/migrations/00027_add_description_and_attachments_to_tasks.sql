@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS task_attachments (
+    id BIGSERIAL PRIMARY KEY,
+    task_id INT NOT NULL,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_key VARCHAR(512) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_attachments_task ON task_attachments(task_id, created_at);
+
+-- attachments also go away by cascade of tasks, goals and users, so their blobs are queued
+-- here by trigger and removed from blob store by background job
+CREATE TABLE IF NOT EXISTS deleted_blobs (
+    storage_key VARCHAR(512) PRIMARY KEY,
+    deleted_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE OR REPLACE FUNCTION queue_deleted_task_attachment_blob() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO deleted_blobs (storage_key) VALUES (OLD.storage_key) ON CONFLICT DO NOTHING;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER task_attachments_queue_deleted_blob
+    AFTER DELETE ON task_attachments
+    FOR EACH ROW EXECUTE FUNCTION queue_deleted_task_attachment_blob();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS task_attachments_queue_deleted_blob ON task_attachments;
+DROP FUNCTION IF EXISTS queue_deleted_task_attachment_blob();
+DROP TABLE IF EXISTS deleted_blobs;
+
+DROP INDEX IF EXISTS idx_task_attachments_task;
+DROP TABLE IF EXISTS task_attachments;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS description;
+-- +goose StatementEnd
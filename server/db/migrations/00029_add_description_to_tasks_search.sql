@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_search;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_search ON tasks
+    USING GIN (to_tsvector('simple', title || ' ' || description));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_search;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_search ON tasks
+    USING GIN (to_tsvector('simple', title));
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- tasks done before completed_at existed take the time of their last completion, or their creation when it wasn't recorded
+UPDATE tasks
+SET completed_at = coalesce(
+    (SELECT max(task_activity.created_at) FROM task_activity
+     WHERE task_activity.task_id = tasks.id AND task_activity.action = 'completed'),
+    created_at
+)
+WHERE is_done = true AND completed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- backfilled times can't be told apart from real ones, they are kept
+SELECT 1;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- name: ListGoalStatisticsByDateRange :many
+SELECT
+    g.id AS goal_id,
+    g.title,
+    g.category_type,
+    count(t.id)::int AS total_tasks,
+    count(t.id) FILTER (WHERE t.is_done = true)::int AS completed_tasks,
+    coalesce(sum(t.duration_minutes), 0)::int AS planned_minutes,
+    coalesce(sum(t.duration_minutes) FILTER (WHERE t.is_done = true), 0)::int AS completed_minutes,
+    coalesce(sum(t.reschedule_count), 0)::int AS total_reschedule_count
+FROM goals g
+LEFT JOIN tasks t ON t.goal_id = g.id
+    AND t.scheduled_date >= sqlc.arg(after_date)::date
+    AND t.scheduled_date <= sqlc.arg(before_date)::date
+WHERE g.user_id = sqlc.arg(user_id)
+GROUP BY g.id
+ORDER BY g.id;
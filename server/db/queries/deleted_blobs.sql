@@ -0,0 +1,8 @@
+-- name: ListDeletedBlobs :many
+SELECT * FROM deleted_blobs
+ORDER BY deleted_at
+LIMIT $1;
+
+-- name: DeleteDeletedBlob :exec
+DELETE FROM deleted_blobs
+WHERE storage_key = $1;
@@ -0,0 +1,21 @@
+-- name: GetExportJobByID :one
+SELECT * FROM export_jobs
+WHERE id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: CreateExportJob :one
+INSERT INTO export_jobs (
+    user_id
+) VALUES (
+    $1
+)
+RETURNING *;
+
+-- name: StartExportJobByID :exec
+UPDATE export_jobs
+SET status = 'running'
+WHERE id = $1;
+
+-- name: FinishExportJobByID :exec
+UPDATE export_jobs
+SET status = $2, file_path = $3, error = $4, finished_at = now()
+WHERE id = $1;
@@ -0,0 +1,29 @@
+-- name: GetGoalInvitationByIDAndEmail :one
+SELECT * FROM goal_invitations
+WHERE id = $1 AND lower(email) = lower(sqlc.arg(email)) LIMIT 1;
+
+-- name: ListGoalInvitationsByGoalID :many
+SELECT * FROM goal_invitations
+WHERE goal_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListGoalInvitationsByEmail :many
+SELECT goal_invitations.id, goal_invitations.goal_id, goals.title AS goal_title, goal_invitations.role, users.email AS invited_by_email, goal_invitations.created_at FROM goal_invitations
+JOIN goals ON goals.id = goal_invitations.goal_id
+JOIN users ON users.id = goal_invitations.invited_by
+WHERE lower(goal_invitations.email) = lower(sqlc.arg(email))
+ORDER BY goal_invitations.created_at DESC, goal_invitations.id DESC;
+
+-- name: CreateGoalInvitation :one
+INSERT INTO goal_invitations (
+    goal_id, email, role, invited_by
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (goal_id, email) DO UPDATE
+SET role = EXCLUDED.role, invited_by = EXCLUDED.invited_by, created_at = now()
+RETURNING *;
+
+-- name: DeleteGoalInvitationByID :execrows
+DELETE FROM goal_invitations
+WHERE id = $1 AND goal_id = $2;
@@ -0,0 +1,29 @@
+-- name: GetRoleInGoalMemberByIDs :one
+SELECT role FROM goal_members
+WHERE goal_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: ListGoalMembersByGoalID :many
+SELECT goal_members.user_id, users.email, goal_members.role, goal_members.created_at FROM goal_members
+JOIN users ON users.id = goal_members.user_id
+WHERE goal_members.goal_id = $1
+ORDER BY goal_members.created_at, goal_members.user_id;
+
+-- name: CreateGoalMember :exec
+INSERT INTO goal_members (
+    goal_id, user_id, role
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (goal_id, user_id) DO UPDATE
+SET role = EXCLUDED.role
+WHERE goal_members.role <> 'owner';
+
+-- name: UpdateRoleInGoalMemberByIDs :one
+UPDATE goal_members
+SET role = $3
+WHERE goal_id = $1 AND user_id = $2 AND role <> 'owner'
+RETURNING *;
+
+-- name: DeleteGoalMemberByIDs :execrows
+DELETE FROM goal_members
+WHERE goal_id = $1 AND user_id = $2 AND role <> 'owner';
@@ -1,24 +1,33 @@
 -- name: GetGoalByID :one
-SELECT * FROM goals
-WHERE id = $1 AND user_id = $2 LIMIT 1;
+SELECT sqlc.embed(goals), goal_members.role FROM goals
+JOIN goal_members ON goal_members.goal_id = goals.id
+WHERE goals.id = $1 AND goal_members.user_id = $2 LIMIT 1;
 
 -- name: ListGoalsByIsArchived :many
-SELECT * FROM goals
-WHERE is_archived = $1 AND user_id = $2
-ORDER BY id;
+SELECT sqlc.embed(goals), goal_members.role FROM goals
+JOIN goal_members ON goal_members.goal_id = goals.id
+WHERE goals.is_archived = $1 AND goal_members.user_id = $2
+ORDER BY goals.id;
 
 -- name: ListGoals :many
-SELECT * FROM goals
-WHERE user_id = $1
-ORDER BY id;
+SELECT sqlc.embed(goals), goal_members.role FROM goals
+JOIN goal_members ON goal_members.goal_id = goals.id
+WHERE goal_members.user_id = $1
+ORDER BY goals.id;
 
 -- name: CreateGoal :one
-INSERT INTO goals (
-    user_id, title, color, category_type
-) VALUES (
-    $1, $2, $3, $4
+WITH goal AS (
+    INSERT INTO goals (
+        user_id, title, color, category_type
+    ) VALUES (
+        $1, $2, $3, $4
+    )
+    RETURNING *
+), goal_owner AS (
+    INSERT INTO goal_members (goal_id, user_id, role)
+    SELECT id, user_id, 'owner' FROM goal
 )
-RETURNING *;
+SELECT * FROM goal;
 
 -- name: UpdateGoalByID :one
 UPDATE goals
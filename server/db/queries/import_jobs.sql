@@ -0,0 +1,26 @@
+-- name: GetImportJobByID :one
+SELECT * FROM import_jobs
+WHERE id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: CreateImportJob :one
+INSERT INTO import_jobs (
+    user_id, goal_id, source
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: StartImportJobByID :exec
+UPDATE import_jobs
+SET status = 'running', total_items = $2
+WHERE id = $1;
+
+-- name: UpdateProgressInImportJobByID :exec
+UPDATE import_jobs
+SET processed_items = $2, failed_items = $3, item_errors = $4
+WHERE id = $1;
+
+-- name: FinishImportJobByID :exec
+UPDATE import_jobs
+SET status = $2, error = $3, finished_at = now()
+WHERE id = $1;
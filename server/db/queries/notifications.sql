@@ -0,0 +1,19 @@
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE user_id = $1 AND (NOT sqlc.arg(unread_only)::bool OR read_at IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT 100;
+
+-- name: CreateNotification :one
+INSERT INTO notifications (
+    user_id, task_id, title, body
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: MarkNotificationAsReadByID :one
+UPDATE notifications
+SET read_at = coalesce(read_at, now())
+WHERE id = $1 AND user_id = $2
+RETURNING *;
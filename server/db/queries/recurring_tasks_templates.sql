@@ -1,6 +1,6 @@
 -- name: GetRecurringTasksTemplateByID :one
 SELECT * FROM recurring_tasks_templates
-WHERE id = $1 AND user_id = $2 LIMIT 1;
+WHERE id = $1 AND (user_id = $2 OR goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = $2)) LIMIT 1;
 
 -- name: ListRecurringTasksTemplates :many
 SELECT * FROM recurring_tasks_templates
@@ -9,9 +9,9 @@ ORDER BY id;
 
 -- name: CreateRecurringTasksTemplate :one
 INSERT INTO recurring_tasks_templates (
-    user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule
+    user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, priority, exdates, rdates, recurrence_mode, recurrence_interval_days, assignee_ids
 ) VALUES (
-             $1, $2, $3, $4, $5, $6, $7
+             $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
          )
     RETURNING *;
 
@@ -23,19 +23,53 @@ SET
     scheduled_datetime = $5,
     has_time = $6,
     duration_minutes = $7,
-    recurrence_rrule = $8
+    recurrence_rrule = $8,
+    priority = $9,
+    exdates = $10,
+    rdates = $11,
+    recurrence_interval_days = $12,
+    assignee_ids = $13
 WHERE id = $1 AND user_id = $2
 RETURNING *;
 
+-- name: AddExdateToRecurringTasksTemplateByID :exec
+UPDATE recurring_tasks_templates
+SET exdates = array_append(exdates, sqlc.arg(exdate)::date)
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id) AND NOT (sqlc.arg(exdate)::date = ANY(exdates));
+
+-- name: UpdateRecurrenceRruleInRecurringTasksTemplateByID :exec
+UPDATE recurring_tasks_templates
+SET recurrence_rrule = $3
+WHERE id = $1 AND user_id = $2;
+
+-- name: ResetLastGeneratedDateInRecurringTasksTemplateByID :one
+UPDATE recurring_tasks_templates
+SET last_generated_date = LEAST(recurring_tasks_templates.last_generated_date, (now() AT TIME ZONE users.time_zone)::date)
+FROM users
+WHERE recurring_tasks_templates.user_id = users.id AND recurring_tasks_templates.id = $1
+RETURNING recurring_tasks_templates.*;
+
+-- name: IncrementAssigneeRotationInRecurringTasksTemplateByID :one
+UPDATE recurring_tasks_templates
+SET assignee_rotation = assignee_rotation + 1
+WHERE id = $1
+RETURNING assignee_rotation;
+
 -- name: DeleteRecurringTasksTemplateByID :exec
 DELETE FROM recurring_tasks_templates
 WHERE id = $1 AND user_id = $2;
 
 -- name: ListRecurringTasksTemplatesDueForGeneration :many
-SELECT * FROM recurring_tasks_templates
-WHERE last_generated_date < (current_date + interval '1 month');
+SELECT recurring_tasks_templates.* FROM recurring_tasks_templates
+JOIN users ON users.id = recurring_tasks_templates.user_id
+WHERE recurring_tasks_templates.last_generated_date < ((now() AT TIME ZONE users.time_zone)::date + interval '1 month')
+  AND recurring_tasks_templates.recurrence_mode = 'calendar';
 
 -- name: UpdateLastGeneratedDateInRecurringTasksTemplateByID :exec
 UPDATE recurring_tasks_templates
 SET last_generated_date = $2
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+-- name: RemoveAssigneeFromRecurringTasksTemplatesByGoalID :exec
+UPDATE recurring_tasks_templates
+SET assignee_ids = array_remove(assignee_ids, sqlc.arg(assignee_id)::int)
+WHERE goal_id = sqlc.arg(goal_id) AND sqlc.arg(assignee_id)::int = ANY(assignee_ids);
@@ -0,0 +1,31 @@
+-- name: GetReminderByID :one
+SELECT * FROM reminders
+WHERE id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: ListRemindersByTaskID :many
+SELECT * FROM reminders
+WHERE task_id = $1 AND user_id = $2
+ORDER BY offset_minutes, id;
+
+-- name: ListRemindersByRecurringTemplateID :many
+SELECT * FROM reminders
+WHERE recurring_template_id = $1 AND user_id = $2
+ORDER BY offset_minutes, id;
+
+-- name: ListEffectiveRemindersByTaskID :many
+SELECT reminders.* FROM reminders
+JOIN tasks ON tasks.id = $1 AND tasks.user_id = $2
+WHERE reminders.task_id = tasks.id OR reminders.recurring_template_id = tasks.recurring_template_id
+ORDER BY reminders.offset_minutes, reminders.id;
+
+-- name: CreateReminder :one
+INSERT INTO reminders (
+    user_id, task_id, recurring_template_id, offset_minutes
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: DeleteReminderByID :exec
+DELETE FROM reminders
+WHERE id = $1 AND user_id = $2;
@@ -0,0 +1,64 @@
+-- name: GetTagByID :one
+SELECT * FROM tags
+WHERE id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: ListTags :many
+SELECT * FROM tags
+WHERE user_id = $1
+ORDER BY title;
+
+-- name: CreateTag :one
+INSERT INTO tags (
+    user_id, title, color
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: UpdateTagByID :one
+UPDATE tags
+SET title = $3, color = $4
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteTagByID :exec
+DELETE FROM tags
+WHERE id = $1 AND user_id = $2;
+
+-- name: ListTagsByTaskIDs :many
+SELECT task_tags.task_id, tags.id, tags.title, tags.color
+FROM task_tags
+JOIN tags ON tags.id = task_tags.tag_id
+WHERE task_tags.task_id = ANY(sqlc.arg(task_ids)::int[])
+ORDER BY tags.title;
+
+-- name: CountTagsByIDs :one
+SELECT count(*)::int FROM tags
+WHERE user_id = sqlc.arg(user_id) AND id = ANY(sqlc.arg(tag_ids)::int[]);
+
+-- name: CreateTaskTags :exec
+INSERT INTO task_tags (task_id, tag_id)
+SELECT sqlc.arg(task_id)::int, tags.id
+FROM tags
+WHERE tags.user_id = sqlc.arg(user_id) AND tags.id = ANY(sqlc.arg(tag_ids)::int[])
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteTaskTagsByTaskID :exec
+DELETE FROM task_tags
+WHERE task_id = $1;
+
+-- name: ListTagIDsByRecurringTasksTemplateIDs :many
+SELECT template_id, tag_id FROM recurring_tasks_template_tags
+WHERE template_id = ANY(sqlc.arg(template_ids)::int[])
+ORDER BY template_id, tag_id;
+
+-- name: CreateRecurringTasksTemplateTags :exec
+INSERT INTO recurring_tasks_template_tags (template_id, tag_id)
+SELECT sqlc.arg(template_id)::int, tags.id
+FROM tags
+WHERE tags.user_id = sqlc.arg(user_id) AND tags.id = ANY(sqlc.arg(tag_ids)::int[])
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteRecurringTasksTemplateTagsByTemplateID :exec
+DELETE FROM recurring_tasks_template_tags
+WHERE template_id = $1;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- name: ListTaskActivityByTaskID :many
+SELECT * FROM task_activity
+WHERE task_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: CreateTaskActivity :exec
+INSERT INTO task_activity (
+    task_id, action, changes
+) VALUES (
+    $1, $2, $3
+);
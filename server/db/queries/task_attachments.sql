@@ -0,0 +1,24 @@
+-- name: ListTaskAttachmentsByTaskID :many
+SELECT * FROM task_attachments
+WHERE task_id = $1
+ORDER BY created_at, id;
+
+-- name: GetTaskAttachmentByID :one
+SELECT * FROM task_attachments
+WHERE id = $1 AND task_id = $2;
+
+-- name: CountTaskAttachmentsByTaskID :one
+SELECT count(*) FROM task_attachments
+WHERE task_id = $1;
+
+-- name: CreateTaskAttachment :one
+INSERT INTO task_attachments (
+    task_id, user_id, file_name, content_type, size_bytes, storage_key
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: DeleteTaskAttachmentByID :execrows
+DELETE FROM task_attachments
+WHERE id = $1 AND task_id = $2;
@@ -0,0 +1,40 @@
+-- name: GetTaskChecklistItemByID :one
+SELECT * FROM task_checklist_items
+WHERE id = $1 AND task_id = $2 LIMIT 1;
+
+-- name: ListTaskChecklistItemsByTaskID :many
+SELECT * FROM task_checklist_items
+WHERE task_id = $1
+ORDER BY position, id;
+
+-- name: CountTaskChecklistItemsByTaskIDs :many
+SELECT
+    task_id,
+    count(*)::int AS total,
+    count(*) FILTER (WHERE is_done = true)::int AS done
+FROM task_checklist_items
+WHERE task_id = ANY(sqlc.arg(task_ids)::int[])
+GROUP BY task_id;
+
+-- name: CreateTaskChecklistItem :one
+INSERT INTO task_checklist_items (
+    task_id, user_id, title, position
+) VALUES (
+    $1, $2, $3, (SELECT COALESCE(MAX(position) + 1, 0) FROM task_checklist_items WHERE task_id = $1)
+)
+RETURNING *;
+
+-- name: UpdateTaskChecklistItemByID :one
+UPDATE task_checklist_items
+SET title = $3, is_done = $4, position = $5
+WHERE id = $1 AND task_id = $2
+RETURNING *;
+
+-- name: UpdateIsDoneInTaskChecklistItemsByTaskID :exec
+UPDATE task_checklist_items
+SET is_done = $2
+WHERE task_id = $1;
+
+-- name: DeleteTaskChecklistItemByID :exec
+DELETE FROM task_checklist_items
+WHERE id = $1 AND task_id = $2;
\ No newline at end of file
@@ -0,0 +1,22 @@
+-- name: ListTaskCommentsByTaskID :many
+SELECT * FROM task_comments
+WHERE task_id = $1
+ORDER BY created_at, id;
+
+-- name: CreateTaskComment :one
+INSERT INTO task_comments (
+    task_id, user_id, body
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: UpdateTaskCommentByID :one
+UPDATE task_comments
+SET body = $4, updated_at = now()
+WHERE id = $1 AND task_id = $2 AND user_id = $3
+RETURNING *;
+
+-- name: DeleteTaskCommentByID :execrows
+DELETE FROM task_comments
+WHERE id = $1 AND task_id = $2 AND user_id = $3;
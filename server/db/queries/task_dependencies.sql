@@ -0,0 +1,38 @@
+-- name: ListTaskBlockersByTaskID :many
+SELECT tasks.* FROM tasks
+JOIN task_dependencies ON task_dependencies.blocked_by_task_id = tasks.id
+WHERE task_dependencies.task_id = $1
+ORDER BY tasks.is_done, tasks.scheduled_date ASC NULLS LAST, tasks.id;
+
+-- name: ListBlockedTaskIDs :many
+SELECT DISTINCT task_dependencies.task_id FROM task_dependencies
+JOIN tasks ON tasks.id = task_dependencies.blocked_by_task_id
+WHERE task_dependencies.task_id = ANY(sqlc.arg(task_ids)::int[])
+  AND tasks.is_done = false;
+
+-- name: HasTaskDependencyPath :one
+WITH RECURSIVE blockers AS (
+    SELECT task_dependencies.blocked_by_task_id FROM task_dependencies
+    WHERE task_dependencies.task_id = sqlc.arg(from_task_id)::int
+    UNION
+    SELECT task_dependencies.blocked_by_task_id FROM task_dependencies
+    JOIN blockers ON task_dependencies.task_id = blockers.blocked_by_task_id
+)
+SELECT EXISTS (
+    SELECT 1 FROM blockers WHERE blocked_by_task_id = sqlc.arg(to_task_id)::int
+) AS has_path;
+
+-- name: LockTaskDependencies :exec
+LOCK TABLE task_dependencies IN SHARE ROW EXCLUSIVE MODE;
+
+-- name: CreateTaskDependency :exec
+INSERT INTO task_dependencies (
+    task_id, blocked_by_task_id
+) VALUES (
+    $1, $2
+)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteTaskDependency :execrows
+DELETE FROM task_dependencies
+WHERE task_id = $1 AND blocked_by_task_id = $2;
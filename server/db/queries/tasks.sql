@@ -1,39 +1,130 @@
 -- name: GetTaskByID :one
 SELECT * FROM tasks
-WHERE id = $1 AND user_id = $2 LIMIT 1;
+WHERE id = $1 AND (user_id = $2 OR goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = $2)) LIMIT 1;
 
--- name: ListTasksByGoalID :many
+-- name: ListAssignedTasks :many
 SELECT * FROM tasks
-WHERE goal_id = $1 AND user_id = $2
-ORDER BY is_done ASC, id DESC;
+WHERE assignee_id = sqlc.arg(user_id)::int
+  AND is_done = false
+  AND (user_id = sqlc.arg(user_id)::int OR goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = sqlc.arg(user_id)::int))
+ORDER BY scheduled_date ASC NULLS LAST, priority DESC, id;
 
 -- name: ListInboxTasks :many
 SELECT * FROM tasks
 WHERE scheduled_date IS null AND has_time = false AND is_done = false AND user_id = $1
-ORDER BY id DESC;
+ORDER BY priority DESC, id DESC;
+
+-- name: ListOverdueTasks :many
+SELECT * FROM tasks
+WHERE user_id = $1 AND is_done = false AND scheduled_date < $2
+ORDER BY priority DESC, scheduled_date ASC, id;
+
+-- name: ListUpcomingTasksByRecurringTemplateID :many
+SELECT * FROM tasks
+WHERE recurring_template_id = $1 AND scheduled_date >= $3
+  AND (user_id = $2 OR goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = $2))
+  AND is_done = false AND has_time = true
+ORDER BY scheduled_date, id;
 
 -- name: ListTasksByDateRange :many
 SELECT * FROM tasks
 WHERE user_id = $3 AND scheduled_date >= $1 AND scheduled_date <= $2
-ORDER BY scheduled_time ASC, id;
+  AND (sqlc.narg(tag_id)::int IS NULL OR id IN (SELECT task_id FROM task_tags WHERE tag_id = sqlc.narg(tag_id)::int))
+ORDER BY priority DESC, scheduled_time ASC, id;
 
 -- name: CountCompletedTasksForToday :one
 SELECT
-    count(*) FILTER (WHERE scheduled_date = current_date)::int AS total_today,
-    count(*) FILTER (WHERE scheduled_date = current_date AND is_done = true)::int AS completed_today
+    count(*)::int AS total_today,
+    count(*) FILTER (WHERE is_done = true)::int AS completed_today
 FROM tasks
-WHERE user_id = $1;
+WHERE user_id = $1 AND scheduled_date = $2;
+
+-- name: CountOpenTasksByRecurringTasksTemplateID :one
+SELECT count(*)::int FROM tasks
+WHERE recurring_template_id = $1 AND is_done = false;
+
+-- name: ListCompletedAtByGoalID :many
+SELECT completed_at FROM tasks
+WHERE goal_id = $1 AND completed_at IS NOT NULL
+ORDER BY completed_at;
 
--- name: ListTasks :many
+-- name: ListOccurrencesByRecurringTasksTemplateID :many
+SELECT scheduled_date, is_done, completed_at FROM tasks
+WHERE recurring_template_id = $1 AND scheduled_date <= $2
+ORDER BY scheduled_date;
+
+-- name: ListRecurringTasksByDateRange :many
+SELECT * FROM tasks
+WHERE user_id = sqlc.arg(user_id)
+  AND recurring_template_id IS NOT NULL
+  AND (sqlc.narg(recurring_template_id)::int IS NULL OR recurring_template_id = sqlc.narg(recurring_template_id)::int)
+  AND scheduled_date >= sqlc.arg(after_date)::date
+  AND scheduled_date <= sqlc.arg(before_date)::date
+ORDER BY scheduled_date, id;
+
+-- name: ListTasksPage :many
 SELECT * FROM tasks
-WHERE user_id = $1
-ORDER BY id;
+WHERE (
+    user_id = sqlc.arg(user_id)
+    OR (sqlc.narg(goal_id)::int IS NOT NULL AND goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = sqlc.arg(user_id)))
+  )
+  AND (sqlc.narg(goal_id)::int IS NULL OR goal_id = sqlc.narg(goal_id)::int)
+  AND (sqlc.narg(tag_id)::int IS NULL OR id IN (SELECT task_id FROM task_tags WHERE tag_id = sqlc.narg(tag_id)::int))
+  AND (sqlc.narg(is_done)::bool IS NULL OR is_done = sqlc.narg(is_done)::bool)
+  AND (sqlc.narg(has_time)::bool IS NULL OR has_time = sqlc.narg(has_time)::bool)
+  AND (sqlc.narg(recurring_template_id)::int IS NULL OR recurring_template_id = sqlc.narg(recurring_template_id)::int)
+  AND (sqlc.narg(scheduled_after)::date IS NULL OR scheduled_date >= sqlc.narg(scheduled_after)::date)
+  AND (sqlc.narg(scheduled_before)::date IS NULL OR scheduled_date <= sqlc.narg(scheduled_before)::date)
+  AND (
+    sqlc.narg(cursor_id)::bigint IS NULL
+    OR priority < sqlc.narg(cursor_priority)::task_priority
+    OR (priority = sqlc.narg(cursor_priority)::task_priority AND is_done > sqlc.narg(cursor_is_done)::bool)
+    OR (priority = sqlc.narg(cursor_priority)::task_priority AND is_done = sqlc.narg(cursor_is_done)::bool AND id < sqlc.narg(cursor_id)::bigint)
+  )
+ORDER BY priority DESC, is_done ASC, id DESC
+LIMIT sqlc.narg(row_limit)::int;
+
+-- name: RollOverOverdueTasks :execrows
+WITH overdue AS (
+    SELECT tasks.id, tasks.scheduled_date, tasks.reschedule_count, (now() AT TIME ZONE users.time_zone)::date AS today
+    FROM tasks
+    JOIN users ON users.id = tasks.user_id
+    WHERE users.auto_rollover = true
+      AND tasks.is_done = false
+      AND tasks.recurring_template_id IS NULL
+      AND tasks.scheduled_date < (now() AT TIME ZONE users.time_zone)::date
+    FOR UPDATE OF tasks
+), activity AS (
+    INSERT INTO task_activity (task_id, action, changes)
+    SELECT id, 'rescheduled', jsonb_build_object(
+        'scheduled_date', jsonb_build_object('old', scheduled_date, 'new', today),
+        'reschedule_count', jsonb_build_object('old', reschedule_count, 'new', reschedule_count + 1)
+    )
+    FROM overdue
+)
+UPDATE tasks
+SET
+    scheduled_date = overdue.today,
+    reschedule_count = tasks.reschedule_count + 1
+FROM overdue
+WHERE tasks.id = overdue.id;
+
+-- name: SearchTasks :many
+SELECT * FROM tasks
+WHERE user_id = sqlc.arg(user_id)
+  AND to_tsvector('simple', title || ' ' || description) @@ websearch_to_tsquery('simple', sqlc.arg(query)::text)
+  AND (sqlc.narg(goal_id)::int IS NULL OR goal_id = sqlc.narg(goal_id)::int)
+  AND (sqlc.narg(is_done)::bool IS NULL OR is_done = sqlc.narg(is_done)::bool)
+  AND (sqlc.narg(after_date)::date IS NULL OR scheduled_date >= sqlc.narg(after_date)::date)
+  AND (sqlc.narg(before_date)::date IS NULL OR scheduled_date <= sqlc.narg(before_date)::date)
+ORDER BY ts_rank(to_tsvector('simple', title || ' ' || description), websearch_to_tsquery('simple', sqlc.arg(query)::text)) DESC, priority DESC, id DESC
+LIMIT 100;
 
 -- name: CreateTask :one
 INSERT INTO tasks (
-    user_id, goal_id, recurring_template_id, title, scheduled_date, has_time, scheduled_time, duration_minutes
+    user_id, goal_id, recurring_template_id, title, scheduled_date, has_time, scheduled_time, duration_minutes, priority, assignee_id, description
 ) VALUES (
-             $1, $2, $3, $4, $5, $6, $7, $8
+             $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
          )
     RETURNING *;
 
@@ -48,20 +139,57 @@ SET
     has_time = $8,
     scheduled_time = $9,
     duration_minutes = $10,
-    reschedule_count = $11
+    reschedule_count = $11,
+    priority = $12,
+    is_recurrence_exception = $13,
+    assignee_id = $14,
+    description = $15,
+    completed_at = CASE WHEN NOT $6 THEN NULL WHEN is_done THEN completed_at ELSE now() END
 WHERE id = $1 AND user_id = $2
 RETURNING *;
 
+-- name: UpdateUpcomingTasksByRecurringTasksTemplateID :many
+UPDATE tasks
+SET
+    goal_id = sqlc.arg(goal_id),
+    title = sqlc.arg(title),
+    has_time = sqlc.arg(has_time),
+    scheduled_time = sqlc.arg(scheduled_time),
+    duration_minutes = sqlc.arg(duration_minutes),
+    priority = sqlc.arg(priority)
+WHERE recurring_template_id = sqlc.arg(recurring_template_id)
+  AND user_id = sqlc.arg(user_id)
+  AND scheduled_date >= sqlc.arg(scheduled_after)::date
+  AND is_done = false
+  AND is_recurrence_exception = false
+RETURNING *;
+
 -- name: UpdateIsDoneInTaskByID :one
 UPDATE tasks
-SET is_done = $3
+SET is_done = $3, completed_at = CASE WHEN NOT $3 THEN NULL WHEN is_done THEN completed_at ELSE now() END
 WHERE id = $1 AND user_id = $2
 RETURNING *;
 
--- name: DeleteTaskByID :exec
+-- name: DeleteTaskByID :execrows
 DELETE FROM tasks
 WHERE id = $1 AND user_id = $2;
 
 -- name: DeleteFutureTasksByRecurringTasksTemplateID :exec
 DELETE FROM tasks
-WHERE recurring_template_id = $1 AND scheduled_date > current_date AND is_done = false;
\ No newline at end of file
+USING users
+WHERE tasks.user_id = users.id
+  AND tasks.recurring_template_id = $1
+  AND tasks.scheduled_date > (now() AT TIME ZONE users.time_zone)::date
+  AND tasks.is_done = false
+  AND tasks.is_recurrence_exception = false;
+
+-- name: DeleteTasksFromDateByRecurringTasksTemplateID :exec
+DELETE FROM tasks
+WHERE recurring_template_id = $1
+  AND user_id = $2
+  AND scheduled_date >= $3
+  AND is_done = false;
+-- name: UnassignTasksByGoalID :exec
+UPDATE tasks
+SET assignee_id = NULL
+WHERE goal_id = $1 AND assignee_id = sqlc.arg(assignee_id)::int;
@@ -6,6 +6,19 @@ WHERE email = $1 LIMIT 1;
 SELECT * FROM users
 WHERE id = $1 LIMIT 1;
 
+-- name: GetUserByCalendarTokenHash :one
+SELECT * FROM users
+WHERE calendar_token_hash = $1 LIMIT 1;
+
+-- name: ListUserTimeZones :many
+SELECT id, time_zone FROM users
+ORDER BY id;
+
+-- name: ListAgendaRecipients :many
+SELECT id, email, time_zone FROM users
+WHERE email_agenda = true
+ORDER BY id;
+
 -- name: CreateUser :one
 INSERT INTO users (
     email, password_hash
@@ -13,3 +26,16 @@ INSERT INTO users (
     $1, $2
 )
 RETURNING *;
+
+
+-- name: UpdateSettingsInUserByID :one
+UPDATE users
+SET time_zone = $2, auto_rollover = $3, email_notifications = $4, email_agenda = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateCalendarTokenHashInUserByID :one
+UPDATE users
+SET calendar_token_hash = $2
+WHERE id = $1
+RETURNING *;
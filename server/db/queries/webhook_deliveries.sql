@@ -0,0 +1,24 @@
+-- name: ListWebhookDeliveriesByWebhookID :many
+SELECT * FROM webhook_deliveries
+WHERE webhook_id = $1
+ORDER BY id DESC
+LIMIT 100;
+
+-- name: GetWebhookDeliveryForSendingByID :one
+SELECT d.id, d.event, d.payload, w.url, w.secret, w.is_active FROM webhook_deliveries d
+JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.id = $1 LIMIT 1;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    webhook_id, event, payload
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: UpdateAttemptInWebhookDeliveryByID :exec
+UPDATE webhook_deliveries
+SET attempts = attempts + 1, status = $2, response_status = $3, error = $4,
+    delivered_at = CASE WHEN $2 = 'succeeded' THEN now() ELSE delivered_at END
+WHERE id = $1;
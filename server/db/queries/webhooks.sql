@@ -0,0 +1,31 @@
+-- name: ListWebhooks :many
+SELECT * FROM webhooks
+WHERE user_id = $1
+ORDER BY id;
+
+-- name: ListActiveWebhooksByEvent :many
+SELECT * FROM webhooks
+WHERE user_id = $1 AND is_active = true AND sqlc.arg(event)::text = ANY(events)
+ORDER BY id;
+
+-- name: GetWebhookByID :one
+SELECT * FROM webhooks
+WHERE id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: CreateWebhook :one
+INSERT INTO webhooks (
+    user_id, url, secret, events
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: UpdateWebhookByID :one
+UPDATE webhooks
+SET url = $3, secret = $4, events = $5, is_active = $6
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteWebhookByID :exec
+DELETE FROM webhooks
+WHERE id = $1 AND user_id = $2;
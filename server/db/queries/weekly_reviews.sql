@@ -0,0 +1,13 @@
+-- name: GetWeeklyReviewByWeekStart :one
+SELECT * FROM weekly_reviews
+WHERE user_id = $1 AND week_start = $2 LIMIT 1;
+
+-- name: UpsertWeeklyReview :one
+INSERT INTO weekly_reviews (
+    user_id, week_start, summary
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (user_id, week_start) DO UPDATE
+SET summary = EXCLUDED.summary, created_at = now()
+RETURNING *;
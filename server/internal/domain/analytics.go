@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+)
+
+type GetAnalyticsInput struct {
+	UserID     int32
+	AfterDate  time.Time
+	BeforeDate time.Time
+}
+
+type TaskStatisticsOutput struct {
+	TotalTasks             int32
+	CompletedTasks         int32
+	PlannedMinutes         int32
+	CompletedMinutes       int32
+	CompletionRate         float64
+	AverageRescheduleCount float64
+}
+
+type GoalStatisticsOutput struct {
+	GoalID       int64
+	Title        string
+	CategoryType string
+	Statistics   TaskStatisticsOutput
+}
+
+type CategoryStatisticsOutput struct {
+	CategoryType string
+	Statistics   TaskStatisticsOutput
+}
+
+type AnalyticsOutput struct {
+	AfterDate  time.Time
+	BeforeDate time.Time
+	Total      TaskStatisticsOutput
+	Goals      []GoalStatisticsOutput
+	Categories []CategoryStatisticsOutput
+}
@@ -0,0 +1,33 @@
+package domain
+
+const (
+	EmailTemplateNotification = "notification"
+	EmailTemplateAgenda       = "agenda"
+	EmailTemplateInvitation   = "invitation"
+)
+
+// EmailMessage is rendered by mailer from html and plain text variants of Template with Data.
+type EmailMessage struct {
+	To       string
+	Subject  string
+	Template string
+	Data     any
+}
+
+type AgendaEmailData struct {
+	Date  string
+	Tasks []AgendaTaskData
+}
+
+type AgendaTaskData struct {
+	Title           string
+	Time            string
+	DurationMinutes int32
+	Priority        string
+}
+
+type InvitationEmailData struct {
+	GoalTitle string
+	InvitedBy string
+	Role      string
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+type ExportJobPayload struct {
+	JobID  int64
+	UserID int32
+}
+
+type ExportJobOutput struct {
+	ID         int64
+	UserID     int32
+	Status     string
+	FilePath   string
+	Error      string
+	CreatedAt  time.Time
+	FinishedAt time.Time
+}
+
+func ToExportJobOutput(job *repo.ExportJob) *ExportJobOutput {
+	return &ExportJobOutput{
+		ID:         job.ID,
+		UserID:     job.UserID,
+		Status:     string(job.Status),
+		FilePath:   job.FilePath.String,
+		Error:      job.Error.String,
+		CreatedAt:  job.CreatedAt.Time,
+		FinishedAt: job.FinishedAt.Time,
+	}
+}
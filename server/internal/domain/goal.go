@@ -30,9 +30,11 @@ type GoalOutput struct {
 	CategoryType string
 	IsArchived   bool
 	CreatedAt    time.Time
+	Role         string
 }
 
-func ToGoalOutput(goal *repo.Goal) *GoalOutput {
+// ToGoalOutput converts goal together with role of user who requested it.
+func ToGoalOutput(goal *repo.Goal, role repo.GoalMemberRole) *GoalOutput {
 	return &GoalOutput{
 		ID:           goal.ID,
 		UserID:       goal.UserID,
@@ -41,13 +43,14 @@ func ToGoalOutput(goal *repo.Goal) *GoalOutput {
 		CategoryType: string(goal.CategoryType),
 		IsArchived:   goal.IsArchived,
 		CreatedAt:    goal.CreatedAt.Time,
+		Role:         string(role),
 	}
 }
 
-func ToGoalOutputList(goals []repo.Goal) []GoalOutput {
+func ToGoalOutputList(goals []repo.ListGoalsRow) []GoalOutput {
 	output := make([]GoalOutput, len(goals))
 	for i, g := range goals {
-		output[i] = *ToGoalOutput(&g)
+		output[i] = *ToGoalOutput(&g.Goal, g.Role)
 	}
 	return output
 }
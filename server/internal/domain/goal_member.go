@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+const (
+	GoalRoleOwner  = "owner"
+	GoalRoleEditor = "editor"
+	GoalRoleViewer = "viewer"
+)
+
+var goalRoleRanks = map[string]int{
+	GoalRoleViewer: 1,
+	GoalRoleEditor: 2,
+	GoalRoleOwner:  3,
+}
+
+var (
+	GoalAccessDeniedError      = errors.New("not enough permissions for goal")
+	GoalOwnerMemberError       = errors.New("owner of goal cannot be changed or removed")
+	GoalMemberNotFoundError    = errors.New("user is not a member of goal")
+	GoalMemberExistsError      = errors.New("user is already a member of goal")
+	InvalidGoalInvitationError = errors.New("invalid goal invitation")
+	InvalidAssigneeError       = errors.New("assignee must have access to goal")
+)
+
+// IsGoalRoleAtLeast reports whether role grants at least the access of required role.
+func IsGoalRoleAtLeast(role string, required string) bool {
+	rank, ok := goalRoleRanks[role]
+	return ok && rank >= goalRoleRanks[required]
+}
+
+type InviteGoalMemberInput struct {
+	GoalID int64
+	UserID int32
+	Email  string
+	Role   string
+}
+
+type UpdateGoalMemberInput struct {
+	GoalID   int64
+	UserID   int32
+	MemberID int32
+	Role     string
+}
+
+type GoalMemberOutput struct {
+	UserID    int32
+	Email     string
+	Role      string
+	CreatedAt time.Time
+}
+
+func ToGoalMemberOutputList(members []repo.ListGoalMembersByGoalIDRow) []GoalMemberOutput {
+	output := make([]GoalMemberOutput, len(members))
+	for i, m := range members {
+		output[i] = GoalMemberOutput{
+			UserID:    m.UserID,
+			Email:     m.Email,
+			Role:      string(m.Role),
+			CreatedAt: m.CreatedAt.Time,
+		}
+	}
+	return output
+}
+
+type GoalInvitationOutput struct {
+	ID             int64
+	GoalID         int32
+	GoalTitle      string
+	Email          string
+	Role           string
+	InvitedBy      int32
+	InvitedByEmail string
+	CreatedAt      time.Time
+}
+
+func ToGoalInvitationOutput(invitation *repo.GoalInvitation) *GoalInvitationOutput {
+	return &GoalInvitationOutput{
+		ID:        invitation.ID,
+		GoalID:    invitation.GoalID,
+		Email:     invitation.Email,
+		Role:      string(invitation.Role),
+		InvitedBy: invitation.InvitedBy,
+		CreatedAt: invitation.CreatedAt.Time,
+	}
+}
+
+func ToGoalInvitationOutputList(invitations []repo.GoalInvitation) []GoalInvitationOutput {
+	output := make([]GoalInvitationOutput, len(invitations))
+	for i, inv := range invitations {
+		output[i] = *ToGoalInvitationOutput(&inv)
+	}
+	return output
+}
+
+func ToReceivedGoalInvitationOutputList(invitations []repo.ListGoalInvitationsByEmailRow) []GoalInvitationOutput {
+	output := make([]GoalInvitationOutput, len(invitations))
+	for i, inv := range invitations {
+		output[i] = GoalInvitationOutput{
+			ID:             inv.ID,
+			GoalID:         inv.GoalID,
+			GoalTitle:      inv.GoalTitle,
+			Role:           string(inv.Role),
+			InvitedByEmail: inv.InvitedByEmail,
+			CreatedAt:      inv.CreatedAt.Time,
+		}
+	}
+	return output
+}
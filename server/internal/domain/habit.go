@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	HabitDayDone        = "done"
+	HabitDayMissed      = "missed"
+	HabitDayPending     = "pending"
+	HabitDayUnscheduled = "unscheduled"
+)
+
+var (
+	InvalidHabitRangeError       = errors.New("invalid habit date range")
+	InvalidHabitCheckInDateError = errors.New("habit can't be checked in on this date")
+)
+
+type GetHabitsInput struct {
+	UserID     int32
+	AfterDate  time.Time
+	BeforeDate time.Time
+}
+
+type HabitCheckInInput struct {
+	UserID     int32
+	TemplateID int64
+	Date       time.Time
+}
+
+type HabitDayOutput struct {
+	Date   time.Time
+	Status string
+	TaskID int64
+}
+
+type HabitOutput struct {
+	TemplateID     int64
+	GoalID         int32
+	Title          string
+	RecurrenceMode string
+	AfterDate      time.Time
+	BeforeDate     time.Time
+	Days           []HabitDayOutput
+	DoneDays       int32
+	MissedDays     int32
+	CompletionRate float64
+}
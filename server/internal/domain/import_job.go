@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+const (
+	ImportSourceIcs      = "ics"
+	ImportSourceTickTick = "ticktick"
+	ImportSourceTodoist  = "todoist"
+)
+
+var InvalidImportFileError = errors.New("invalid import file")
+
+type CreateImportJobInput struct {
+	UserID  int32
+	GoalID  int32
+	Source  string
+	Content []byte
+}
+
+type ImportJobPayload struct {
+	JobID   int64
+	UserID  int32
+	GoalID  int32
+	Content []byte
+}
+
+type ImportCsvInput struct {
+	UserID   int32
+	Source   string
+	FileName string
+	Content  []byte
+	DryRun   bool
+}
+
+type ImportRowError struct {
+	Row   int
+	Error string
+}
+
+type ImportCsvOutput struct {
+	Source            string
+	DryRun            bool
+	TotalRows         int
+	ImportedTasks     int
+	ImportedTemplates int
+	NewGoals          []string
+	RowErrors         []ImportRowError
+}
+
+type ImportJobOutput struct {
+	ID             int64
+	UserID         int32
+	GoalID         int32
+	Source         string
+	Status         string
+	TotalItems     int32
+	ProcessedItems int32
+	FailedItems    int32
+	ItemErrors     []string
+	Error          string
+	CreatedAt      time.Time
+	FinishedAt     time.Time
+}
+
+func ToImportJobOutput(job *repo.ImportJob) *ImportJobOutput {
+	return &ImportJobOutput{
+		ID:             job.ID,
+		UserID:         job.UserID,
+		GoalID:         job.GoalID,
+		Source:         job.Source,
+		Status:         string(job.Status),
+		TotalItems:     job.TotalItems,
+		ProcessedItems: job.ProcessedItems,
+		FailedItems:    job.FailedItems,
+		ItemErrors:     job.ItemErrors,
+		Error:          job.Error.String,
+		CreatedAt:      job.CreatedAt.Time,
+		FinishedAt:     job.FinishedAt.Time,
+	}
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"io"
 	"time"
 
 	repo "github.com/ali-nur31/mile-do/internal/repository/db"
@@ -34,6 +35,11 @@ type UserService interface {
 	GetUserByEmail(ctx context.Context, email string) (*UserOutput, error)
 	GetUserByID(ctx context.Context, id int64) (*UserOutput, error)
 	CreateUser(ctx context.Context, qtx repo.Querier, user AuthInput) (*UserOutput, error)
+	UpdateUser(ctx context.Context, input UpdateUserInput) (*UserOutput, error)
+	GetUserLocation(ctx context.Context, qtx repo.Querier, id int64) (*time.Location, error)
+	GetUserByCalendarToken(ctx context.Context, token string) (*UserOutput, error)
+	RotateCalendarToken(ctx context.Context, id int64) (string, error)
+	RevokeCalendarToken(ctx context.Context, id int64) error
 }
 
 type GoalService interface {
@@ -44,32 +50,195 @@ type GoalService interface {
 	DeleteGoalByID(ctx context.Context, id int64, userId int32) error
 }
 
+type GoalMemberService interface {
+	AuthorizeGoal(ctx context.Context, goalId int64, userId int32, requiredRole string) error
+	AuthorizeGoalItemChange(ctx context.Context, goalId int32, ownerId int32, userId int32) error
+	AuthorizeAssignees(ctx context.Context, goalId int32, ownerId int32, assigneeIds []int32) error
+	ListGoalMembers(ctx context.Context, goalId int64, userId int32) ([]GoalMemberOutput, error)
+	UpdateGoalMember(ctx context.Context, input UpdateGoalMemberInput) error
+	RemoveGoalMember(ctx context.Context, goalId int64, memberId int32, userId int32) error
+	InviteGoalMember(ctx context.Context, input InviteGoalMemberInput) (*GoalInvitationOutput, error)
+	ListGoalInvitations(ctx context.Context, goalId int64, userId int32) ([]GoalInvitationOutput, error)
+	DeleteGoalInvitation(ctx context.Context, goalId int64, invitationId int64, userId int32) error
+	ListReceivedGoalInvitations(ctx context.Context, userId int32) ([]GoalInvitationOutput, error)
+	AcceptGoalInvitation(ctx context.Context, id int64, userId int32) (*GoalOutput, error)
+	DeclineGoalInvitation(ctx context.Context, id int64, userId int32) error
+}
+
 type RecurringTasksTemplateService interface {
 	ListRecurringTasksTemplates(ctx context.Context, userId int32) ([]RecurringTasksTemplateOutput, error)
 	GetRecurringTasksTemplateByID(ctx context.Context, id int64, userId int32) (*RecurringTasksTemplateOutput, error)
 	CreateRecurringTasksTemplate(ctx context.Context, input CreateRecurringTasksTemplateInput) (*RecurringTasksTemplateOutput, error)
+	ImportRecurringTasksTemplate(ctx context.Context, qtx repo.Querier, input CreateRecurringTasksTemplateInput) (*RecurringTasksTemplateOutput, error)
 	UpdateRecurringTasksTemplateByID(ctx context.Context, dbTemplate RecurringTasksTemplateOutput, updatingTemplate UpdateRecurringTasksTemplateInput) (*RecurringTasksTemplateOutput, error)
 	DeleteRecurringTasksTemplateByID(ctx context.Context, id int64, userId int32) error
 	ListRecurringTasksTemplatesDueForGeneration(ctx context.Context, qtx repo.Querier) ([]RecurringTasksTemplateOutput, error)
 	UpdateLastGeneratedDateInRecurringTasksTemplateByID(ctx context.Context, qtx repo.Querier, updatingTemplate UpdateLastGeneratedDateInRecurringTasksTemplateInput) error
+	UpdateRecurringTasksTemplateDetailsByID(ctx context.Context, qtx repo.Querier, dbTemplate RecurringTasksTemplateOutput, updatingTemplate UpdateRecurringTasksTemplateInput) (*RecurringTasksTemplateOutput, error)
+	AddExdateToRecurringTasksTemplateByID(ctx context.Context, qtx repo.Querier, id int64, userId int32, exdate time.Time) error
+	EndRecurringTasksTemplateByID(ctx context.Context, qtx repo.Querier, dbTemplate RecurringTasksTemplateOutput, until time.Time) error
+	SplitRecurringTasksTemplateByID(ctx context.Context, qtx repo.Querier, dbTemplate RecurringTasksTemplateOutput, until time.Time, input CreateRecurringTasksTemplateInput) (*RecurringTasksTemplateOutput, error)
 }
 
 type TaskService interface {
-	ListTasksByGoalID(ctx context.Context, userId int32, goalId int32) ([]TaskOutput, error)
 	ListInboxTasks(ctx context.Context, userId int32) ([]TaskOutput, error)
+	ListAssignedTasks(ctx context.Context, userId int32) ([]TaskOutput, error)
 	ListTasksByPeriod(ctx context.Context, period GetTasksByPeriodInput) ([]TaskOutput, error)
-	ListTasks(ctx context.Context, userId int32) ([]TaskOutput, error)
+	ListTasks(ctx context.Context, input ListTasksInput) (*TaskPageOutput, error)
+	SearchTasks(ctx context.Context, input SearchTasksInput) ([]TaskOutput, error)
+	ListOverdueTasks(ctx context.Context, userId int32) ([]TaskOutput, error)
 	GetTaskByID(ctx context.Context, id int64, userId int32) (*TaskOutput, error)
+	ListTaskActivity(ctx context.Context, taskId int64) ([]TaskActivityOutput, error)
 	CreateTask(ctx context.Context, input CreateTaskInput) (*TaskOutput, error)
+	ImportTask(ctx context.Context, qtx repo.Querier, input CreateTaskInput, isDone bool) (*TaskOutput, error)
 	UpdateTask(ctx context.Context, dbTask TaskOutput, updatingTask UpdateTaskInput) (*TaskOutput, error)
-	CompleteTask(ctx context.Context, userId int32, taskId int64) (*TaskOutput, error)
+	CompleteTask(ctx context.Context, userId int32, taskId int64, withChecklistItems bool) (*TaskOutput, error)
+	UncompleteTask(ctx context.Context, dbTask TaskOutput) (*TaskOutput, error)
+	CheckInRecurringTask(ctx context.Context, template RecurringTasksTemplateOutput, date time.Time) (*TaskOutput, error)
+	GetGoalStreak(ctx context.Context, goalId int64, userId int32) (*StreakOutput, error)
+	GetRecurringTasksTemplateStreak(ctx context.Context, templateId int64, userId int32) (*StreakOutput, error)
 	AnalyzeForToday(ctx context.Context, userId int32) (*TodayProgressOutput, error)
 	DeleteTaskByID(ctx context.Context, id int64, userId int32) error
+	DeleteRecurringTaskByID(ctx context.Context, dbTask TaskOutput, scope RecurrenceScope) error
 	DeleteFutureTasksByRecurringTasksTemplateID(ctx context.Context, templateId int64) error
+	RollOverOverdueTasks(ctx context.Context) (int64, error)
 	CreateTasksByRecurringTasksTemplatesDueForGeneration(ctx context.Context, qtx repo.Querier) error
 	CreateTasksByRecurringTasksTemplate(ctx context.Context, qtx repo.Querier, template RecurringTasksTemplateOutput) error
 }
 
+type TaskCommentService interface {
+	ListTaskComments(ctx context.Context, taskId int32) ([]TaskCommentOutput, error)
+	CreateTaskComment(ctx context.Context, input CreateTaskCommentInput) (*TaskCommentOutput, error)
+	UpdateTaskComment(ctx context.Context, input UpdateTaskCommentInput) (*TaskCommentOutput, error)
+	DeleteTaskCommentByID(ctx context.Context, id int64, taskId int32, userId int32) error
+}
+
+type TaskDependencyService interface {
+	ListTaskBlockers(ctx context.Context, taskId int32) ([]TaskOutput, error)
+	AddTaskDependency(ctx context.Context, task TaskOutput, blocker TaskOutput) error
+	RemoveTaskDependency(ctx context.Context, taskId int32, blockerId int32) error
+}
+
+type TaskAttachmentService interface {
+	ListTaskAttachments(ctx context.Context, taskId int32) ([]TaskAttachmentOutput, error)
+	CreateTaskAttachment(ctx context.Context, input CreateTaskAttachmentInput) (*TaskAttachmentOutput, error)
+	OpenTaskAttachment(ctx context.Context, id int64, taskId int32) (*TaskAttachmentOutput, io.ReadCloser, error)
+	DeleteTaskAttachmentByID(ctx context.Context, id int64, taskId int32) error
+	PurgeDeletedBlobs(ctx context.Context) (int64, error)
+}
+
+// BlobStore keeps content of files by key, Get returns BlobNotFoundError for unknown key.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type TaskChecklistItemService interface {
+	ListTaskChecklistItems(ctx context.Context, taskId int32) ([]TaskChecklistItemOutput, error)
+	GetTaskChecklistItemByID(ctx context.Context, id int64, taskId int32) (*TaskChecklistItemOutput, error)
+	CreateTaskChecklistItem(ctx context.Context, input CreateTaskChecklistItemInput) (*TaskChecklistItemOutput, error)
+	UpdateTaskChecklistItem(ctx context.Context, input UpdateTaskChecklistItemInput) (*TaskChecklistItemOutput, error)
+	DeleteTaskChecklistItemByID(ctx context.Context, id int64, taskId int32) error
+	CompleteTaskChecklistItemsByTaskID(ctx context.Context, qtx repo.Querier, taskId int32) error
+	GetTaskChecklistProgressByTaskIDs(ctx context.Context, taskIds []int32) (map[int32]TaskChecklistProgressOutput, error)
+}
+
+type TagService interface {
+	ListTags(ctx context.Context, userId int32) ([]TagOutput, error)
+	GetTagByID(ctx context.Context, id int64, userId int32) (*TagOutput, error)
+	CreateTag(ctx context.Context, input CreateTagInput) (*TagOutput, error)
+	UpdateTag(ctx context.Context, input UpdateTagInput) (*TagOutput, error)
+	DeleteTagByID(ctx context.Context, id int64, userId int32) error
+	ListTagsByTaskIDs(ctx context.Context, taskIds []int32) (map[int32][]TagOutput, error)
+	SetTaskTags(ctx context.Context, qtx repo.Querier, taskId int32, userId int32, tagIds []int32) error
+	ListTagIDsByRecurringTasksTemplateIDs(ctx context.Context, qtx repo.Querier, templateIds []int32) (map[int32][]int32, error)
+	SetRecurringTasksTemplateTags(ctx context.Context, qtx repo.Querier, templateId int32, userId int32, tagIds []int32) error
+}
+
+type ReminderService interface {
+	ListTaskReminders(ctx context.Context, taskId int32, userId int32) ([]ReminderOutput, error)
+	ListRecurringTasksTemplateReminders(ctx context.Context, templateId int32, userId int32) ([]ReminderOutput, error)
+	GetReminderByID(ctx context.Context, id int64, userId int32) (*ReminderOutput, error)
+	CreateReminder(ctx context.Context, input CreateReminderInput) (*ReminderOutput, error)
+	DeleteReminderByID(ctx context.Context, id int64, userId int32) error
+	CopyRecurringTasksTemplateReminders(ctx context.Context, qtx repo.Querier, fromTemplateId int32, toTemplateId int32, userId int32) error
+	ScheduleTaskReminders(ctx context.Context, qtx repo.Querier, task TaskOutput) error
+	CancelTaskReminders(ctx context.Context, taskId int64, userId int32) error
+	DeliverReminder(ctx context.Context, payload ReminderPayload) error
+}
+
+type NotificationService interface {
+	ListNotifications(ctx context.Context, userId int32, unreadOnly bool) ([]NotificationOutput, error)
+	MarkNotificationAsRead(ctx context.Context, id int64, userId int32) (*NotificationOutput, error)
+	Notify(ctx context.Context, input NotificationInput) error
+}
+
+// Notifier delivers notification to user through a single channel, e.g. in-app inbox or webhook.
+type Notifier interface {
+	Notify(ctx context.Context, input NotificationInput) error
+}
+
+type Mailer interface {
+	Send(ctx context.Context, message EmailMessage) error
+}
+
+type WebhookService interface {
+	ListWebhooks(ctx context.Context, userId int32) ([]WebhookOutput, error)
+	GetWebhookByID(ctx context.Context, id int64, userId int32) (*WebhookOutput, error)
+	CreateWebhook(ctx context.Context, input CreateWebhookInput) (*WebhookOutput, error)
+	UpdateWebhook(ctx context.Context, input UpdateWebhookInput) (*WebhookOutput, error)
+	DeleteWebhookByID(ctx context.Context, id int64, userId int32) error
+	ListWebhookDeliveries(ctx context.Context, webhookId int64, userId int32) ([]WebhookDeliveryOutput, error)
+	DispatchEvent(ctx context.Context, userId int32, event string, data any)
+	DeliverWebhook(ctx context.Context, payload WebhookDeliveryPayload) error
+}
+
+// WebhookSender posts signed webhook request and returns http status of response,
+// ValidateUrl returns InvalidWebhookUrlError for urls the sender refuses to deliver to.
+type WebhookSender interface {
+	Send(ctx context.Context, request WebhookRequest) (int, error)
+	ValidateUrl(ctx context.Context, url string) error
+}
+
+type AgendaService interface {
+	SendDailyAgendas(ctx context.Context) (int64, error)
+}
+
+type HabitService interface {
+	ListHabits(ctx context.Context, input GetHabitsInput) ([]HabitOutput, error)
+	GetHabitByID(ctx context.Context, templateId int64, input GetHabitsInput) (*HabitOutput, error)
+	CheckInHabit(ctx context.Context, input HabitCheckInInput) (*TaskOutput, error)
+	UndoHabitCheckIn(ctx context.Context, input HabitCheckInInput) error
+}
+
+type AnalyticsService interface {
+	GetAnalytics(ctx context.Context, input GetAnalyticsInput) (*AnalyticsOutput, error)
+}
+
+type WeeklyReviewService interface {
+	GetWeeklyReview(ctx context.Context, userId int32, weekStart time.Time) (*WeeklyReviewOutput, error)
+	GetWeeklyReviewMarkdown(ctx context.Context, userId int32, weekStart time.Time) ([]byte, error)
+	GenerateWeeklyReviews(ctx context.Context) (int64, error)
+}
+
+type CalendarService interface {
+	GetCalendarFeed(ctx context.Context, token string) ([]byte, error)
+}
+
+type ImportService interface {
+	CreateImportJob(ctx context.Context, input CreateImportJobInput) (*ImportJobOutput, error)
+	GetImportJobByID(ctx context.Context, id int64, userId int32) (*ImportJobOutput, error)
+	ImportIcs(ctx context.Context, payload ImportJobPayload) error
+	ImportCsv(ctx context.Context, input ImportCsvInput) (*ImportCsvOutput, error)
+}
+
+type ExportService interface {
+	CreateExportJob(ctx context.Context, userId int32) (*ExportJobOutput, error)
+	GetExportJobByID(ctx context.Context, id int64, userId int32) (*ExportJobOutput, error)
+	ExportUserData(ctx context.Context, payload ExportJobPayload) error
+}
+
 type AuthCacheRepo interface {
 	BlockToken(ctx context.Context, tokenID string, duration time.Duration) error
 	IsTokenBlocked(ctx context.Context, tokenID string) (bool, error)
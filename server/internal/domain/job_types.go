@@ -11,6 +11,14 @@ const (
 	TypeGenerateRecurringTasksDueForGeneration = "generate:recurring:tasks:due:for:generation"
 	TypeGenerateRecurringTasksByTemplate       = "generate:recurring:tasks:by:template"
 	TypeDeleteRecurringTasksByTemplateID       = "delete:recurring:tasks:by:template:id"
+	TypeRollOverOverdueTasks                   = "roll:over:overdue:tasks"
+	TypeImportIcs                              = "import:ics"
+	TypeExportUserData                         = "export:user:data"
+	TypeGenerateWeeklyReviews                  = "generate:weekly:reviews"
+	TypeDeliverReminder                        = "deliver:reminder"
+	TypeSendDailyAgendas                       = "send:daily:agendas"
+	TypeDeliverWebhook                         = "deliver:webhook"
+	TypePurgeDeletedBlobs                      = "purge:deleted:blobs"
 )
 
 func NewGenerateRecurringTasksDueForGenerationTask() *asynq.Task {
@@ -27,6 +35,10 @@ func NewGenerateRecurringTasksByTemplateTask(template *RecurringTasksTemplateOut
 	return asynq.NewTask(TypeGenerateRecurringTasksByTemplate, encodedPayload)
 }
 
+func NewRollOverOverdueTasksTask() *asynq.Task {
+	return asynq.NewTask(TypeRollOverOverdueTasks, []byte{})
+}
+
 func NewDeleteRecurringTasksByTemplateIDTask(id int64) *asynq.Task {
 	encodedPayload, err := json.Marshal(id)
 	if err != nil {
@@ -36,3 +48,55 @@ func NewDeleteRecurringTasksByTemplateIDTask(id int64) *asynq.Task {
 
 	return asynq.NewTask(TypeDeleteRecurringTasksByTemplateID, encodedPayload)
 }
+
+func NewImportIcsTask(payload *ImportJobPayload) *asynq.Task {
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		slog.Error("couldn't convert map to bytes", "error", err)
+		return nil
+	}
+
+	return asynq.NewTask(TypeImportIcs, encodedPayload)
+}
+
+func NewExportUserDataTask(payload *ExportJobPayload) *asynq.Task {
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		slog.Error("couldn't convert map to bytes", "error", err)
+		return nil
+	}
+
+	return asynq.NewTask(TypeExportUserData, encodedPayload)
+}
+
+func NewGenerateWeeklyReviewsTask() *asynq.Task {
+	return asynq.NewTask(TypeGenerateWeeklyReviews, []byte{})
+}
+
+func NewDeliverReminderTask(payload *ReminderPayload) *asynq.Task {
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		slog.Error("couldn't convert map to bytes", "error", err)
+		return nil
+	}
+
+	return asynq.NewTask(TypeDeliverReminder, encodedPayload)
+}
+
+func NewSendDailyAgendasTask() *asynq.Task {
+	return asynq.NewTask(TypeSendDailyAgendas, []byte{})
+}
+
+func NewDeliverWebhookTask(payload *WebhookDeliveryPayload) *asynq.Task {
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		slog.Error("couldn't convert map to bytes", "error", err)
+		return nil
+	}
+
+	return asynq.NewTask(TypeDeliverWebhook, encodedPayload)
+}
+
+func NewPurgeDeletedBlobsTask() *asynq.Task {
+	return asynq.NewTask(TypePurgeDeletedBlobs, []byte{})
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+type NotificationInput struct {
+	UserID int32
+	TaskID int64
+	Title  string
+	Body   string
+}
+
+type NotificationOutput struct {
+	ID        int64
+	UserID    int32
+	TaskID    int32
+	Title     string
+	Body      string
+	ReadAt    time.Time
+	CreatedAt time.Time
+}
+
+func ToNotificationOutput(notification *repo.Notification) *NotificationOutput {
+	return &NotificationOutput{
+		ID:        notification.ID,
+		UserID:    notification.UserID,
+		TaskID:    notification.TaskID.Int32,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		ReadAt:    notification.ReadAt.Time,
+		CreatedAt: notification.CreatedAt.Time,
+	}
+}
+
+func ToNotificationOutputList(notifications []repo.Notification) []NotificationOutput {
+	output := make([]NotificationOutput, len(notifications))
+	for i, notification := range notifications {
+		output[i] = *ToNotificationOutput(&notification)
+	}
+	return output
+}
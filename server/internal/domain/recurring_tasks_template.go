@@ -4,27 +4,41 @@ import (
 	"time"
 
 	"github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type CreateRecurringTasksTemplateInput struct {
-	UserID            int32
-	GoalID            int32
-	Title             string
-	ScheduledDatetime time.Time
-	HasTime           bool
-	DurationMinutes   int32
-	RecurrenceRrule   string
+	UserID                 int32
+	GoalID                 int32
+	Title                  string
+	ScheduledDatetime      time.Time
+	HasTime                bool
+	DurationMinutes        int32
+	RecurrenceRrule        string
+	RecurrenceMode         string
+	RecurrenceIntervalDays int32
+	Priority               string
+	Exdates                []time.Time
+	Rdates                 []time.Time
+	AssigneeIDs            []int32
+	TagIDs                 []int32
 }
 
 type UpdateRecurringTasksTemplateInput struct {
-	ID                int64
-	UserID            int32
-	GoalID            int32
-	Title             string
-	ScheduledDatetime time.Time
-	HasTime           bool
-	DurationMinutes   int32
-	RecurrenceRrule   string
+	ID                     int64
+	UserID                 int32
+	GoalID                 int32
+	Title                  string
+	ScheduledDatetime      time.Time
+	HasTime                bool
+	DurationMinutes        int32
+	RecurrenceRrule        string
+	RecurrenceIntervalDays int32
+	Priority               string
+	Exdates                []time.Time
+	Rdates                 []time.Time
+	AssigneeIDs            []int32
+	TagIDs                 []int32
 }
 
 type UpdateLastGeneratedDateInRecurringTasksTemplateInput struct {
@@ -33,30 +47,43 @@ type UpdateLastGeneratedDateInRecurringTasksTemplateInput struct {
 }
 
 type RecurringTasksTemplateOutput struct {
-	ID                int64
-	UserID            int32
-	GoalID            int32
-	Title             string
-	ScheduledDatetime time.Time
-	HasTime           bool
-	DurationMinutes   int32
-	RecurrenceRrule   string
-	LastGeneratedDate time.Time
-	CreatedAt         time.Time
+	ID                     int64
+	UserID                 int32
+	GoalID                 int32
+	Title                  string
+	ScheduledDatetime      time.Time
+	HasTime                bool
+	DurationMinutes        int32
+	RecurrenceRrule        string
+	RecurrenceMode         string
+	RecurrenceIntervalDays int32
+	Priority               string
+	Exdates                []time.Time
+	Rdates                 []time.Time
+	LastGeneratedDate      time.Time
+	AssigneeIDs            []int32
+	TagIDs                 []int32
+	CreatedAt              time.Time
 }
 
 func ToRecurringTasksTemplateOutput(template *repo.RecurringTasksTemplate) *RecurringTasksTemplateOutput {
 	return &RecurringTasksTemplateOutput{
-		ID:                template.ID,
-		UserID:            template.UserID,
-		GoalID:            template.GoalID,
-		Title:             template.Title,
-		ScheduledDatetime: template.ScheduledDatetime.Time,
-		HasTime:           template.HasTime,
-		DurationMinutes:   template.DurationMinutes,
-		RecurrenceRrule:   template.RecurrenceRrule,
-		LastGeneratedDate: template.LastGeneratedDate.Time,
-		CreatedAt:         template.CreatedAt.Time,
+		ID:                     template.ID,
+		UserID:                 template.UserID,
+		GoalID:                 template.GoalID,
+		Title:                  template.Title,
+		ScheduledDatetime:      template.ScheduledDatetime.Time,
+		HasTime:                template.HasTime,
+		DurationMinutes:        template.DurationMinutes,
+		RecurrenceRrule:        template.RecurrenceRrule,
+		RecurrenceMode:         string(template.RecurrenceMode),
+		RecurrenceIntervalDays: template.RecurrenceIntervalDays,
+		Priority:               string(template.Priority),
+		Exdates:                toDates(template.Exdates),
+		Rdates:                 toDates(template.Rdates),
+		LastGeneratedDate:      template.LastGeneratedDate.Time,
+		AssigneeIDs:            template.AssigneeIds,
+		CreatedAt:              template.CreatedAt.Time,
 	}
 }
 
@@ -71,3 +98,11 @@ func ToRecurringTasksTemplateOutputList(templates []repo.RecurringTasksTemplate)
 	}
 	return output
 }
+
+func toDates(dates []pgtype.Date) []time.Time {
+	output := make([]time.Time, len(dates))
+	for i, date := range dates {
+		output[i] = date.Time
+	}
+	return output
+}
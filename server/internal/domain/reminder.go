@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+type CreateReminderInput struct {
+	UserID              int32
+	TaskID              int32
+	RecurringTemplateID int32
+	OffsetMinutes       int32
+}
+
+type ReminderPayload struct {
+	ReminderID int64
+	TaskID     int64
+	UserID     int32
+	RemindAt   time.Time
+}
+
+type ReminderOutput struct {
+	ID                  int64
+	UserID              int32
+	TaskID              int32
+	RecurringTemplateID int32
+	OffsetMinutes       int32
+	CreatedAt           time.Time
+}
+
+func ToReminderOutput(reminder *repo.Reminder) *ReminderOutput {
+	return &ReminderOutput{
+		ID:                  reminder.ID,
+		UserID:              reminder.UserID,
+		TaskID:              reminder.TaskID.Int32,
+		RecurringTemplateID: reminder.RecurringTemplateID.Int32,
+		OffsetMinutes:       reminder.OffsetMinutes,
+		CreatedAt:           reminder.CreatedAt.Time,
+	}
+}
+
+func ToReminderOutputList(reminders []repo.Reminder) []ReminderOutput {
+	output := make([]ReminderOutput, len(reminders))
+	for i, reminder := range reminders {
+		output[i] = *ToReminderOutput(&reminder)
+	}
+	return output
+}
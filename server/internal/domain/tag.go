@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+var InvalidTagError = errors.New("tags must belong to owner of task or template")
+
+type CreateTagInput struct {
+	UserID int32
+	Title  string
+	Color  string
+}
+
+type UpdateTagInput struct {
+	ID     int64
+	UserID int32
+	Title  string
+	Color  string
+}
+
+type TagOutput struct {
+	ID        int64
+	UserID    int32
+	Title     string
+	Color     string
+	CreatedAt time.Time
+}
+
+func ToTagOutput(tag *repo.Tag) *TagOutput {
+	return &TagOutput{
+		ID:        tag.ID,
+		UserID:    tag.UserID,
+		Title:     tag.Title,
+		Color:     tag.Color.String,
+		CreatedAt: tag.CreatedAt.Time,
+	}
+}
+
+func ToTagOutputList(tags []repo.Tag) []TagOutput {
+	output := make([]TagOutput, len(tags))
+	for i, t := range tags {
+		output[i] = *ToTagOutput(&t)
+	}
+	return output
+}
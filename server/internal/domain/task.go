@@ -1,25 +1,69 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/ali-nur31/mile-do/internal/repository/db"
 )
 
+type RecurrenceScope string
+
+const (
+	RecurrenceScopeThis      RecurrenceScope = "this"
+	RecurrenceScopeFollowing RecurrenceScope = "following"
+	RecurrenceScopeAll       RecurrenceScope = "all"
+)
+
+var TaskNotFoundError = errors.New("task not found")
+
 type GetTasksByPeriodInput struct {
 	UserID     int32
 	AfterDate  time.Time
 	BeforeDate time.Time
+	TagID      int32
+}
+
+type TaskCursor struct {
+	Priority string
+	IsDone   bool
+	ID       int64
+}
+
+type ListTasksInput struct {
+	UserID              int32
+	GoalID              int32
+	TagID               int32
+	IsDone              *bool
+	HasTime             *bool
+	RecurringTemplateID int32
+	ScheduledAfter      time.Time
+	ScheduledBefore     time.Time
+	Limit               int32
+	Cursor              *TaskCursor
+}
+
+type SearchTasksInput struct {
+	UserID     int32
+	Query      string
+	GoalID     int32
+	IsDone     *bool
+	AfterDate  time.Time
+	BeforeDate time.Time
 }
 
 type CreateTaskInput struct {
 	UserID          int32
 	GoalID          int32
 	Title           string
+	Description     string
 	ScheduledDate   time.Time
 	ScheduledTime   time.Time
 	HasTime         bool
 	DurationMinutes int32
+	Priority        string
+	AssigneeID      int32
+	TagIDs          []int32
 }
 
 type UpdateTaskInput struct {
@@ -27,12 +71,22 @@ type UpdateTaskInput struct {
 	UserID          int32
 	GoalID          int32
 	Title           string
+	Description     string
 	IsDone          bool
 	ScheduledDate   time.Time
 	ScheduledTime   time.Time
 	HasTime         bool
 	DurationMinutes int32
 	RescheduleCount int32
+	Priority        string
+	AssigneeID      int32
+	TagIDs          []int32
+	Scope           RecurrenceScope
+}
+
+type TaskPageOutput struct {
+	Tasks      []TaskOutput
+	NextCursor *TaskCursor
 }
 
 type TodayProgressOutput struct {
@@ -40,32 +94,54 @@ type TodayProgressOutput struct {
 	CompletedToday int32
 }
 
+// StreakOutput counts days with a completed task for goals and occurrences completed in time for recurring tasks templates.
+type StreakOutput struct {
+	CurrentStreak int32
+	LongestStreak int32
+}
+
 type TaskOutput struct {
-	ID              int64
-	UserID          int32
-	GoalID          int32
-	Title           string
-	IsDone          bool
-	ScheduledDate   time.Time
-	ScheduledTime   time.Time
-	HasTime         bool
-	DurationMinutes int32
-	RescheduleCount int32
-	CreatedAt       time.Time
+	ID                    int64
+	UserID                int32
+	GoalID                int32
+	RecurringTemplateID   int32
+	Title                 string
+	Description           string
+	IsDone                bool
+	ScheduledDate         time.Time
+	ScheduledTime         time.Time
+	HasTime               bool
+	DurationMinutes       int32
+	RescheduleCount       int32
+	Priority              string
+	IsRecurrenceException bool
+	AssigneeID            int32
+	Blocked               bool
+	ChecklistProgress     TaskChecklistProgressOutput
+	Tags                  []TagOutput
+	CompletedAt           time.Time
+	CreatedAt             time.Time
 }
 
 func ToTaskOutput(t *repo.Task) *TaskOutput {
 	return &TaskOutput{
-		ID:              t.ID,
-		UserID:          t.UserID,
-		GoalID:          t.GoalID,
-		Title:           t.Title,
-		IsDone:          t.IsDone,
-		ScheduledDate:   t.ScheduledDate.Time,
-		ScheduledTime:   microsecondsToTime(t.ScheduledTime.Microseconds),
-		HasTime:         t.HasTime,
-		DurationMinutes: t.DurationMinutes.Int32,
-		CreatedAt:       t.CreatedAt.Time,
+		ID:                    t.ID,
+		UserID:                t.UserID,
+		GoalID:                t.GoalID,
+		RecurringTemplateID:   t.RecurringTemplateID.Int32,
+		Title:                 t.Title,
+		Description:           t.Description,
+		IsDone:                t.IsDone,
+		ScheduledDate:         t.ScheduledDate.Time,
+		ScheduledTime:         microsecondsToTime(t.ScheduledTime.Microseconds),
+		HasTime:               t.HasTime,
+		DurationMinutes:       t.DurationMinutes.Int32,
+		RescheduleCount:       t.RescheduleCount,
+		Priority:              string(t.Priority),
+		IsRecurrenceException: t.IsRecurrenceException,
+		AssigneeID:            t.AssigneeID.Int32,
+		CompletedAt:           t.CompletedAt.Time,
+		CreatedAt:             t.CreatedAt.Time,
 	}
 }
 
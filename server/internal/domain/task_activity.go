@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	TaskActivityCreated     = "created"
+	TaskActivityUpdated     = "updated"
+	TaskActivityRescheduled = "rescheduled"
+	TaskActivityCompleted   = "completed"
+	TaskActivityUncompleted = "uncompleted"
+	TaskActivityDeleted     = "deleted"
+)
+
+// TaskFieldChangeOutput holds old and new value of changed task field, dates are formatted as 2006-01-02 and times as 15:04.
+type TaskFieldChangeOutput struct {
+	Old any
+	New any
+}
+
+type TaskActivityOutput struct {
+	ID        int64
+	TaskID    int32
+	Action    string
+	Changes   map[string]TaskFieldChangeOutput
+	CreatedAt time.Time
+}
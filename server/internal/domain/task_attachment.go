@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+var (
+	TaskAttachmentNotFoundError = errors.New("attachment not found")
+	TaskAttachmentTooLargeError = errors.New("attachment is larger than allowed size")
+	TaskAttachmentLimitError    = errors.New("task has reached the limit of attachments")
+	BlobNotFoundError           = errors.New("blob not found")
+)
+
+type CreateTaskAttachmentInput struct {
+	TaskID      int32
+	UserID      int32
+	FileName    string
+	ContentType string
+	Size        int64
+	Content     io.Reader
+}
+
+type TaskAttachmentOutput struct {
+	ID          int64
+	TaskID      int32
+	UserID      int32
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	StorageKey  string
+	CreatedAt   time.Time
+}
+
+func ToTaskAttachmentOutput(attachment *repo.TaskAttachment) *TaskAttachmentOutput {
+	return &TaskAttachmentOutput{
+		ID:          attachment.ID,
+		TaskID:      attachment.TaskID,
+		UserID:      attachment.UserID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		StorageKey:  attachment.StorageKey,
+		CreatedAt:   attachment.CreatedAt.Time,
+	}
+}
+
+func ToTaskAttachmentOutputList(attachments []repo.TaskAttachment) []TaskAttachmentOutput {
+	output := make([]TaskAttachmentOutput, len(attachments))
+	for i, attachment := range attachments {
+		output[i] = *ToTaskAttachmentOutput(&attachment)
+	}
+	return output
+}
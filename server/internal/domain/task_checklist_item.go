@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+type CreateTaskChecklistItemInput struct {
+	TaskID int32
+	UserID int32
+	Title  string
+}
+
+type UpdateTaskChecklistItemInput struct {
+	ID       int64
+	TaskID   int32
+	Title    string
+	IsDone   bool
+	Position int32
+}
+
+type TaskChecklistProgressOutput struct {
+	Done  int32
+	Total int32
+}
+
+type TaskChecklistItemOutput struct {
+	ID        int64
+	TaskID    int32
+	UserID    int32
+	Title     string
+	IsDone    bool
+	Position  int32
+	CreatedAt time.Time
+}
+
+func ToTaskChecklistItemOutput(item *repo.TaskChecklistItem) *TaskChecklistItemOutput {
+	return &TaskChecklistItemOutput{
+		ID:        item.ID,
+		TaskID:    item.TaskID,
+		UserID:    item.UserID,
+		Title:     item.Title,
+		IsDone:    item.IsDone,
+		Position:  item.Position,
+		CreatedAt: item.CreatedAt.Time,
+	}
+}
+
+func ToTaskChecklistItemOutputList(items []repo.TaskChecklistItem) []TaskChecklistItemOutput {
+	output := make([]TaskChecklistItemOutput, len(items))
+	for i, item := range items {
+		output[i] = *ToTaskChecklistItemOutput(&item)
+	}
+	return output
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+var TaskCommentNotFoundError = errors.New("comment not found or written by another user")
+
+type CreateTaskCommentInput struct {
+	TaskID int32
+	UserID int32
+	Body   string
+}
+
+type UpdateTaskCommentInput struct {
+	ID     int64
+	TaskID int32
+	UserID int32
+	Body   string
+}
+
+type TaskCommentOutput struct {
+	ID        int64
+	TaskID    int32
+	UserID    int32
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func ToTaskCommentOutput(comment *repo.TaskComment) *TaskCommentOutput {
+	return &TaskCommentOutput{
+		ID:        comment.ID,
+		TaskID:    comment.TaskID,
+		UserID:    comment.UserID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt.Time,
+		UpdatedAt: comment.UpdatedAt.Time,
+	}
+}
+
+func ToTaskCommentOutputList(comments []repo.TaskComment) []TaskCommentOutput {
+	output := make([]TaskCommentOutput, len(comments))
+	for i, comment := range comments {
+		output[i] = *ToTaskCommentOutput(&comment)
+	}
+	return output
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+var (
+	TaskBlockedError            = errors.New("task is blocked by unfinished tasks")
+	TaskDependencyCycleError    = errors.New("dependency would create a cycle")
+	InvalidTaskDependencyError  = errors.New("task can only be blocked by another task of the same owner")
+	TaskDependencyNotFoundError = errors.New("dependency not found")
+)
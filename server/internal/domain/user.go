@@ -6,18 +6,36 @@ import (
 	"github.com/ali-nur31/mile-do/internal/repository/db"
 )
 
+type UpdateUserInput struct {
+	ID                 int64
+	TimeZone           string
+	AutoRollover       bool
+	EmailNotifications bool
+	EmailAgenda        bool
+}
+
 type UserOutput struct {
-	ID           int64
-	Email        string
-	PasswordHash string
-	CreatedAt    time.Time
+	ID                 int64
+	Email              string
+	PasswordHash       string
+	TimeZone           string
+	AutoRollover       bool
+	EmailNotifications bool
+	EmailAgenda        bool
+	HasCalendarToken   bool
+	CreatedAt          time.Time
 }
 
 func ToUserOutput(u *repo.User) *UserOutput {
 	return &UserOutput{
-		ID:           u.ID,
-		Email:        u.Email,
-		PasswordHash: u.PasswordHash,
-		CreatedAt:    u.CreatedAt.Time,
+		ID:                 u.ID,
+		Email:              u.Email,
+		PasswordHash:       u.PasswordHash,
+		TimeZone:           u.TimeZone,
+		AutoRollover:       u.AutoRollover,
+		EmailNotifications: u.EmailNotifications,
+		EmailAgenda:        u.EmailAgenda,
+		HasCalendarToken:   u.CalendarTokenHash.Valid,
+		CreatedAt:          u.CreatedAt.Time,
 	}
 }
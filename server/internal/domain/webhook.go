@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+const (
+	WebhookEventTaskCreated   = "task.created"
+	WebhookEventTaskUpdated   = "task.updated"
+	WebhookEventTaskCompleted = "task.completed"
+	WebhookEventTaskDeleted   = "task.deleted"
+	WebhookEventGoalCreated   = "goal.created"
+	WebhookEventGoalUpdated   = "goal.updated"
+	WebhookEventGoalDeleted   = "goal.deleted"
+	WebhookEventReminderDue   = "reminder.due"
+)
+
+var WebhookEvents = []string{
+	WebhookEventTaskCreated,
+	WebhookEventTaskUpdated,
+	WebhookEventTaskCompleted,
+	WebhookEventTaskDeleted,
+	WebhookEventGoalCreated,
+	WebhookEventGoalUpdated,
+	WebhookEventGoalDeleted,
+	WebhookEventReminderDue,
+}
+
+var (
+	InvalidWebhookEventError = errors.New("invalid webhook event")
+	InvalidWebhookUrlError   = errors.New("webhook url must point to a public http(s) address")
+)
+
+func IsWebhookEvent(event string) bool {
+	return slices.Contains(WebhookEvents, event)
+}
+
+type CreateWebhookInput struct {
+	UserID int32
+	Url    string
+	Secret string
+	Events []string
+}
+
+type UpdateWebhookInput struct {
+	ID       int64
+	UserID   int32
+	Url      string
+	Secret   string
+	Events   []string
+	IsActive bool
+}
+
+type WebhookDeliveryPayload struct {
+	DeliveryID int64
+}
+
+// WebhookRequest is a single signed delivery attempt of event to Url.
+type WebhookRequest struct {
+	Url        string
+	Secret     string
+	Event      string
+	DeliveryID int64
+	Body       []byte
+}
+
+type WebhookOutput struct {
+	ID        int64
+	UserID    int32
+	Url       string
+	Events    []string
+	IsActive  bool
+	CreatedAt time.Time
+}
+
+func ToWebhookOutput(webhook *repo.Webhook) *WebhookOutput {
+	return &WebhookOutput{
+		ID:        webhook.ID,
+		UserID:    webhook.UserID,
+		Url:       webhook.Url,
+		Events:    webhook.Events,
+		IsActive:  webhook.IsActive,
+		CreatedAt: webhook.CreatedAt.Time,
+	}
+}
+
+func ToWebhookOutputList(webhooks []repo.Webhook) []WebhookOutput {
+	output := make([]WebhookOutput, len(webhooks))
+	for i, w := range webhooks {
+		output[i] = *ToWebhookOutput(&w)
+	}
+	return output
+}
+
+type WebhookDeliveryOutput struct {
+	ID             int64
+	WebhookID      int32
+	Event          string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	ResponseStatus int32
+	Error          string
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+}
+
+func ToWebhookDeliveryOutput(delivery *repo.WebhookDelivery) *WebhookDeliveryOutput {
+	return &WebhookDeliveryOutput{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus.Int32,
+		Error:          delivery.Error.String,
+		CreatedAt:      delivery.CreatedAt.Time,
+		DeliveredAt:    delivery.DeliveredAt.Time,
+	}
+}
+
+func ToWebhookDeliveryOutputList(deliveries []repo.WebhookDelivery) []WebhookDeliveryOutput {
+	output := make([]WebhookDeliveryOutput, len(deliveries))
+	for i, d := range deliveries {
+		output[i] = *ToWebhookDeliveryOutput(&d)
+	}
+	return output
+}
+
+// WebhookTaskData is task as it's sent in payload of task events.
+type WebhookTaskData struct {
+	ID                  int64    `json:"id"`
+	GoalID              int32    `json:"goal_id"`
+	RecurringTemplateID int32    `json:"recurring_template_id,omitempty"`
+	Title               string   `json:"title"`
+	IsDone              bool     `json:"is_done"`
+	ScheduledDate       string   `json:"scheduled_date,omitempty"`
+	ScheduledTime       string   `json:"scheduled_time,omitempty"`
+	DurationMinutes     int32    `json:"duration_minutes"`
+	Priority            string   `json:"priority"`
+	Tags                []string `json:"tags"`
+	CompletedAt         string   `json:"completed_at,omitempty"`
+	CreatedAt           string   `json:"created_at"`
+}
+
+func ToWebhookTaskData(task *TaskOutput) WebhookTaskData {
+	output := WebhookTaskData{
+		ID:                  task.ID,
+		GoalID:              task.GoalID,
+		RecurringTemplateID: task.RecurringTemplateID,
+		Title:               task.Title,
+		IsDone:              task.IsDone,
+		DurationMinutes:     task.DurationMinutes,
+		Priority:            task.Priority,
+		Tags:                make([]string, len(task.Tags)),
+		CreatedAt:           task.CreatedAt.Format(time.RFC3339),
+	}
+
+	if !task.ScheduledDate.IsZero() {
+		output.ScheduledDate = task.ScheduledDate.Format(time.DateOnly)
+	}
+	if task.HasTime {
+		output.ScheduledTime = task.ScheduledTime.Format(time.TimeOnly)
+	}
+	if !task.CompletedAt.IsZero() {
+		output.CompletedAt = task.CompletedAt.Format(time.RFC3339)
+	}
+	for i, tag := range task.Tags {
+		output.Tags[i] = tag.Title
+	}
+
+	return output
+}
+
+// WebhookGoalData is goal as it's sent in payload of goal events.
+type WebhookGoalData struct {
+	ID           int64  `json:"id"`
+	Title        string `json:"title"`
+	Color        string `json:"color"`
+	CategoryType string `json:"category_type"`
+	IsArchived   bool   `json:"is_archived"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func ToWebhookGoalData(goal *GoalOutput) WebhookGoalData {
+	return WebhookGoalData{
+		ID:           goal.ID,
+		Title:        goal.Title,
+		Color:        goal.Color,
+		CategoryType: goal.CategoryType,
+		IsArchived:   goal.IsArchived,
+		CreatedAt:    goal.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// WebhookReminderData is payload data of reminder events, sent to webhooks of user who set the reminder.
+type WebhookReminderData struct {
+	TaskID int64  `json:"task_id"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// WebhookDeletedData is payload data of delete events, only id of removed entity is known.
+type WebhookDeletedData struct {
+	ID int64 `json:"id"`
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var InvalidReviewWeekError = errors.New("invalid review week")
+
+type ReviewGoalOutput struct {
+	GoalID         int64
+	Title          string
+	TotalTasks     int32
+	CompletedTasks int32
+}
+
+type ReviewTaskOutput struct {
+	TaskID          int64
+	GoalID          int32
+	Title           string
+	ScheduledDate   time.Time
+	RescheduleCount int32
+}
+
+type WeeklyReviewOutput struct {
+	ID                   int64
+	UserID               int32
+	WeekStart            time.Time
+	WeekEnd              time.Time
+	TotalTasks           int32
+	CompletedTasks       int32
+	PlannedMinutes       int32
+	CompletedMinutes     int32
+	Goals                []ReviewGoalOutput
+	OverdueTasks         []ReviewTaskOutput
+	MostRescheduledTasks []ReviewTaskOutput
+	CreatedAt            time.Time
+}
@@ -10,11 +10,27 @@ import (
 type JobRouter struct {
 	server                        *asynq.Server
 	recurringTasksTemplatesWorker *workers.RecurringTasksTemplatesWorker
+	tasksWorker                   *workers.TasksWorker
+	importsWorker                 *workers.ImportsWorker
+	exportsWorker                 *workers.ExportsWorker
+	weeklyReviewsWorker           *workers.WeeklyReviewsWorker
+	remindersWorker               *workers.RemindersWorker
+	agendasWorker                 *workers.AgendasWorker
+	webhooksWorker                *workers.WebhooksWorker
+	attachmentsWorker             *workers.AttachmentsWorker
 }
 
 func NewJobRouter(
 	cfg *config.Redis,
 	recurringTasksTemplatesWorker *workers.RecurringTasksTemplatesWorker,
+	tasksWorker *workers.TasksWorker,
+	importsWorker *workers.ImportsWorker,
+	exportsWorker *workers.ExportsWorker,
+	weeklyReviewsWorker *workers.WeeklyReviewsWorker,
+	remindersWorker *workers.RemindersWorker,
+	agendasWorker *workers.AgendasWorker,
+	webhooksWorker *workers.WebhooksWorker,
+	attachmentsWorker *workers.AttachmentsWorker,
 ) *JobRouter {
 	server := asynq.NewServer(
 		asynq.RedisClientOpt{
@@ -35,6 +51,14 @@ func NewJobRouter(
 	return &JobRouter{
 		server:                        server,
 		recurringTasksTemplatesWorker: recurringTasksTemplatesWorker,
+		tasksWorker:                   tasksWorker,
+		importsWorker:                 importsWorker,
+		exportsWorker:                 exportsWorker,
+		weeklyReviewsWorker:           weeklyReviewsWorker,
+		remindersWorker:               remindersWorker,
+		agendasWorker:                 agendasWorker,
+		webhooksWorker:                webhooksWorker,
+		attachmentsWorker:             attachmentsWorker,
 	}
 }
 
@@ -44,6 +68,14 @@ func (w *JobRouter) Run() error {
 	mux.HandleFunc(domain.TypeGenerateRecurringTasksDueForGeneration, w.recurringTasksTemplatesWorker.GenerateRecurringTasksDueForGeneration)
 	mux.HandleFunc(domain.TypeGenerateRecurringTasksByTemplate, w.recurringTasksTemplatesWorker.GenerateRecurringTasksByTemplate)
 	mux.HandleFunc(domain.TypeDeleteRecurringTasksByTemplateID, w.recurringTasksTemplatesWorker.DeleteRecurringTasksByTemplateID)
+	mux.HandleFunc(domain.TypeRollOverOverdueTasks, w.tasksWorker.RollOverOverdueTasks)
+	mux.HandleFunc(domain.TypeImportIcs, w.importsWorker.ImportIcs)
+	mux.HandleFunc(domain.TypeExportUserData, w.exportsWorker.ExportUserData)
+	mux.HandleFunc(domain.TypeGenerateWeeklyReviews, w.weeklyReviewsWorker.GenerateWeeklyReviews)
+	mux.HandleFunc(domain.TypeDeliverReminder, w.remindersWorker.DeliverReminder)
+	mux.HandleFunc(domain.TypeSendDailyAgendas, w.agendasWorker.SendDailyAgendas)
+	mux.HandleFunc(domain.TypeDeliverWebhook, w.webhooksWorker.DeliverWebhook)
+	mux.HandleFunc(domain.TypePurgeDeletedBlobs, w.attachmentsWorker.PurgeDeletedBlobs)
 
 	return w.server.Run(mux)
 }
@@ -0,0 +1,32 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/hibiken/asynq"
+)
+
+type AgendasWorker struct {
+	service domain.AgendaService
+}
+
+func NewAgendasWorker(service domain.AgendaService) *AgendasWorker {
+	return &AgendasWorker{
+		service: service,
+	}
+}
+
+func (w *AgendasWorker) SendDailyAgendas(ctx context.Context, t *asynq.Task) error {
+	slog.Info("executing daily agendas sending job")
+
+	sent, err := w.service.SendDailyAgendas(ctx)
+	if err != nil {
+		slog.Error("failed to execute daily agendas sending job", "error", err)
+		return err
+	}
+
+	slog.Info("ended execution of daily agendas sending job", "sent", sent)
+	return nil
+}
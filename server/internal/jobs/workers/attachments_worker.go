@@ -0,0 +1,32 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/hibiken/asynq"
+)
+
+type AttachmentsWorker struct {
+	service domain.TaskAttachmentService
+}
+
+func NewAttachmentsWorker(service domain.TaskAttachmentService) *AttachmentsWorker {
+	return &AttachmentsWorker{
+		service: service,
+	}
+}
+
+func (w *AttachmentsWorker) PurgeDeletedBlobs(ctx context.Context, t *asynq.Task) error {
+	slog.Info("executing deleted blobs purging job")
+
+	purged, err := w.service.PurgeDeletedBlobs(ctx)
+	if err != nil {
+		slog.Error("failed to execute deleted blobs purging job", "error", err, "purged", purged)
+		return err
+	}
+
+	slog.Info("ended execution of deleted blobs purging job", "purged", purged)
+	return nil
+}
@@ -0,0 +1,40 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/hibiken/asynq"
+)
+
+type ExportsWorker struct {
+	service domain.ExportService
+}
+
+func NewExportsWorker(service domain.ExportService) *ExportsWorker {
+	return &ExportsWorker{
+		service: service,
+	}
+}
+
+func (w *ExportsWorker) ExportUserData(ctx context.Context, t *asynq.Task) error {
+	slog.Info("executing user data export job")
+
+	var payload domain.ExportJobPayload
+	err := json.Unmarshal(t.Payload(), &payload)
+	if err != nil {
+		slog.Error("Error unmarshalling bytes to map", "error", err)
+		return err
+	}
+
+	err = w.service.ExportUserData(ctx, payload)
+	if err != nil {
+		slog.Error("failed to execute user data export job", "job_id", payload.JobID, "error", err)
+		return err
+	}
+
+	slog.Info("ended execution of user data export job", "job_id", payload.JobID)
+	return nil
+}
@@ -0,0 +1,40 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/hibiken/asynq"
+)
+
+type ImportsWorker struct {
+	service domain.ImportService
+}
+
+func NewImportsWorker(service domain.ImportService) *ImportsWorker {
+	return &ImportsWorker{
+		service: service,
+	}
+}
+
+func (w *ImportsWorker) ImportIcs(ctx context.Context, t *asynq.Task) error {
+	slog.Info("executing ics import job")
+
+	var payload domain.ImportJobPayload
+	err := json.Unmarshal(t.Payload(), &payload)
+	if err != nil {
+		slog.Error("Error unmarshalling bytes to map", "error", err)
+		return err
+	}
+
+	err = w.service.ImportIcs(ctx, payload)
+	if err != nil {
+		slog.Error("failed to execute ics import job", "job_id", payload.JobID, "error", err)
+		return err
+	}
+
+	slog.Info("ended execution of ics import job", "job_id", payload.JobID)
+	return nil
+}
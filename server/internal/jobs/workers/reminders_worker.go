@@ -0,0 +1,40 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/hibiken/asynq"
+)
+
+type RemindersWorker struct {
+	service domain.ReminderService
+}
+
+func NewRemindersWorker(service domain.ReminderService) *RemindersWorker {
+	return &RemindersWorker{
+		service: service,
+	}
+}
+
+func (w *RemindersWorker) DeliverReminder(ctx context.Context, t *asynq.Task) error {
+	slog.Info("executing reminder delivery job")
+
+	var payload domain.ReminderPayload
+	err := json.Unmarshal(t.Payload(), &payload)
+	if err != nil {
+		slog.Error("Error unmarshalling bytes to map", "error", err)
+		return err
+	}
+
+	err = w.service.DeliverReminder(ctx, payload)
+	if err != nil {
+		slog.Error("failed to execute reminder delivery job", "reminder_id", payload.ReminderID, "task_id", payload.TaskID, "error", err)
+		return err
+	}
+
+	slog.Info("ended execution of reminder delivery job", "reminder_id", payload.ReminderID, "task_id", payload.TaskID)
+	return nil
+}
@@ -0,0 +1,32 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/hibiken/asynq"
+)
+
+type TasksWorker struct {
+	service domain.TaskService
+}
+
+func NewTasksWorker(service domain.TaskService) *TasksWorker {
+	return &TasksWorker{
+		service: service,
+	}
+}
+
+func (w *TasksWorker) RollOverOverdueTasks(ctx context.Context, t *asynq.Task) error {
+	slog.Info("executing overdue tasks roll over job")
+
+	rolledOver, err := w.service.RollOverOverdueTasks(ctx)
+	if err != nil {
+		slog.Error("failed to execute overdue tasks roll over job", "error", err)
+		return err
+	}
+
+	slog.Info("ended execution of overdue tasks roll over job", "rolled_over", rolledOver)
+	return nil
+}
@@ -0,0 +1,40 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/hibiken/asynq"
+)
+
+type WebhooksWorker struct {
+	service domain.WebhookService
+}
+
+func NewWebhooksWorker(service domain.WebhookService) *WebhooksWorker {
+	return &WebhooksWorker{
+		service: service,
+	}
+}
+
+func (w *WebhooksWorker) DeliverWebhook(ctx context.Context, t *asynq.Task) error {
+	slog.Info("executing webhook delivery job")
+
+	var payload domain.WebhookDeliveryPayload
+	err := json.Unmarshal(t.Payload(), &payload)
+	if err != nil {
+		slog.Error("Error unmarshalling bytes to map", "error", err)
+		return err
+	}
+
+	err = w.service.DeliverWebhook(ctx, payload)
+	if err != nil {
+		slog.Error("failed to execute webhook delivery job", "delivery_id", payload.DeliveryID, "error", err)
+		return err
+	}
+
+	slog.Info("ended execution of webhook delivery job", "delivery_id", payload.DeliveryID)
+	return nil
+}
@@ -0,0 +1,32 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/hibiken/asynq"
+)
+
+type WeeklyReviewsWorker struct {
+	service domain.WeeklyReviewService
+}
+
+func NewWeeklyReviewsWorker(service domain.WeeklyReviewService) *WeeklyReviewsWorker {
+	return &WeeklyReviewsWorker{
+		service: service,
+	}
+}
+
+func (w *WeeklyReviewsWorker) GenerateWeeklyReviews(ctx context.Context, t *asynq.Task) error {
+	slog.Info("executing weekly reviews generation job")
+
+	generated, err := w.service.GenerateWeeklyReviews(ctx)
+	if err != nil {
+		slog.Error("failed to execute weekly reviews generation job", "error", err)
+		return err
+	}
+
+	slog.Info("ended execution of weekly reviews generation job", "generated", generated)
+	return nil
+}
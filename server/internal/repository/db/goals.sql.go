@@ -12,12 +12,18 @@ import (
 )
 
 const createGoal = `-- name: CreateGoal :one
-INSERT INTO goals (
-    user_id, title, color, category_type
-) VALUES (
-    $1, $2, $3, $4
+WITH goal AS (
+    INSERT INTO goals (
+        user_id, title, color, category_type
+    ) VALUES (
+        $1, $2, $3, $4
+    )
+    RETURNING id, user_id, title, color, category_type, is_archived, created_at
+), goal_owner AS (
+    INSERT INTO goal_members (goal_id, user_id, role)
+    SELECT id, user_id, 'owner' FROM goal
 )
-RETURNING id, user_id, title, color, category_type, is_archived, created_at
+SELECT id, user_id, title, color, category_type, is_archived, created_at FROM goal
 `
 
 type CreateGoalParams struct {
@@ -63,8 +69,9 @@ func (q *Queries) DeleteGoalByID(ctx context.Context, arg DeleteGoalByIDParams)
 }
 
 const getGoalByID = `-- name: GetGoalByID :one
-SELECT id, user_id, title, color, category_type, is_archived, created_at FROM goals
-WHERE id = $1 AND user_id = $2 LIMIT 1
+SELECT goals.id, goals.user_id, goals.title, goals.color, goals.category_type, goals.is_archived, goals.created_at, goal_members.role FROM goals
+JOIN goal_members ON goal_members.goal_id = goals.id
+WHERE goals.id = $1 AND goal_members.user_id = $2 LIMIT 1
 `
 
 type GetGoalByIDParams struct {
@@ -72,44 +79,57 @@ type GetGoalByIDParams struct {
 	UserID int32 `json:"user_id"`
 }
 
-func (q *Queries) GetGoalByID(ctx context.Context, arg GetGoalByIDParams) (Goal, error) {
+type GetGoalByIDRow struct {
+	Goal Goal           `json:"goal"`
+	Role GoalMemberRole `json:"role"`
+}
+
+func (q *Queries) GetGoalByID(ctx context.Context, arg GetGoalByIDParams) (GetGoalByIDRow, error) {
 	row := q.db.QueryRow(ctx, getGoalByID, arg.ID, arg.UserID)
-	var i Goal
+	var i GetGoalByIDRow
 	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Title,
-		&i.Color,
-		&i.CategoryType,
-		&i.IsArchived,
-		&i.CreatedAt,
+		&i.Goal.ID,
+		&i.Goal.UserID,
+		&i.Goal.Title,
+		&i.Goal.Color,
+		&i.Goal.CategoryType,
+		&i.Goal.IsArchived,
+		&i.Goal.CreatedAt,
+		&i.Role,
 	)
 	return i, err
 }
 
 const listGoals = `-- name: ListGoals :many
-SELECT id, user_id, title, color, category_type, is_archived, created_at FROM goals
-WHERE user_id = $1
-ORDER BY id
+SELECT goals.id, goals.user_id, goals.title, goals.color, goals.category_type, goals.is_archived, goals.created_at, goal_members.role FROM goals
+JOIN goal_members ON goal_members.goal_id = goals.id
+WHERE goal_members.user_id = $1
+ORDER BY goals.id
 `
 
-func (q *Queries) ListGoals(ctx context.Context, userID int32) ([]Goal, error) {
+type ListGoalsRow struct {
+	Goal Goal           `json:"goal"`
+	Role GoalMemberRole `json:"role"`
+}
+
+func (q *Queries) ListGoals(ctx context.Context, userID int32) ([]ListGoalsRow, error) {
 	rows, err := q.db.Query(ctx, listGoals, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Goal
+	var items []ListGoalsRow
 	for rows.Next() {
-		var i Goal
+		var i ListGoalsRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.Title,
-			&i.Color,
-			&i.CategoryType,
-			&i.IsArchived,
-			&i.CreatedAt,
+			&i.Goal.ID,
+			&i.Goal.UserID,
+			&i.Goal.Title,
+			&i.Goal.Color,
+			&i.Goal.CategoryType,
+			&i.Goal.IsArchived,
+			&i.Goal.CreatedAt,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -122,9 +142,10 @@ func (q *Queries) ListGoals(ctx context.Context, userID int32) ([]Goal, error) {
 }
 
 const listGoalsByIsArchived = `-- name: ListGoalsByIsArchived :many
-SELECT id, user_id, title, color, category_type, is_archived, created_at FROM goals
-WHERE is_archived = $1 AND user_id = $2
-ORDER BY id
+SELECT goals.id, goals.user_id, goals.title, goals.color, goals.category_type, goals.is_archived, goals.created_at, goal_members.role FROM goals
+JOIN goal_members ON goal_members.goal_id = goals.id
+WHERE goals.is_archived = $1 AND goal_members.user_id = $2
+ORDER BY goals.id
 `
 
 type ListGoalsByIsArchivedParams struct {
@@ -132,23 +153,29 @@ type ListGoalsByIsArchivedParams struct {
 	UserID     int32 `json:"user_id"`
 }
 
-func (q *Queries) ListGoalsByIsArchived(ctx context.Context, arg ListGoalsByIsArchivedParams) ([]Goal, error) {
+type ListGoalsByIsArchivedRow struct {
+	Goal Goal           `json:"goal"`
+	Role GoalMemberRole `json:"role"`
+}
+
+func (q *Queries) ListGoalsByIsArchived(ctx context.Context, arg ListGoalsByIsArchivedParams) ([]ListGoalsByIsArchivedRow, error) {
 	rows, err := q.db.Query(ctx, listGoalsByIsArchived, arg.IsArchived, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Goal
+	var items []ListGoalsByIsArchivedRow
 	for rows.Next() {
-		var i Goal
+		var i ListGoalsByIsArchivedRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.Title,
-			&i.Color,
-			&i.CategoryType,
-			&i.IsArchived,
-			&i.CreatedAt,
+			&i.Goal.ID,
+			&i.Goal.UserID,
+			&i.Goal.Title,
+			&i.Goal.Color,
+			&i.Goal.CategoryType,
+			&i.Goal.IsArchived,
+			&i.Goal.CreatedAt,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -11,6 +11,93 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ExportJobStatus string
+
+const (
+	ExportJobStatusPending   ExportJobStatus = "pending"
+	ExportJobStatusRunning   ExportJobStatus = "running"
+	ExportJobStatusCompleted ExportJobStatus = "completed"
+	ExportJobStatusFailed    ExportJobStatus = "failed"
+)
+
+func (e *ExportJobStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ExportJobStatus(s)
+	case string:
+		*e = ExportJobStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ExportJobStatus: %T", src)
+	}
+	return nil
+}
+
+type NullExportJobStatus struct {
+	ExportJobStatus ExportJobStatus `json:"export_job_status"`
+	Valid           bool            `json:"valid"` // Valid is true if ExportJobStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullExportJobStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ExportJobStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ExportJobStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullExportJobStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ExportJobStatus), nil
+}
+
+type GoalMemberRole string
+
+const (
+	GoalMemberRoleOwner  GoalMemberRole = "owner"
+	GoalMemberRoleEditor GoalMemberRole = "editor"
+	GoalMemberRoleViewer GoalMemberRole = "viewer"
+)
+
+func (e *GoalMemberRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = GoalMemberRole(s)
+	case string:
+		*e = GoalMemberRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for GoalMemberRole: %T", src)
+	}
+	return nil
+}
+
+type NullGoalMemberRole struct {
+	GoalMemberRole GoalMemberRole `json:"goal_member_role"`
+	Valid          bool           `json:"valid"` // Valid is true if GoalMemberRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullGoalMemberRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.GoalMemberRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.GoalMemberRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullGoalMemberRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.GoalMemberRole), nil
+}
+
 type GoalsCategoryType string
 
 const (
@@ -54,6 +141,240 @@ func (ns NullGoalsCategoryType) Value() (driver.Value, error) {
 	return string(ns.GoalsCategoryType), nil
 }
 
+type ImportJobStatus string
+
+const (
+	ImportJobStatusPending   ImportJobStatus = "pending"
+	ImportJobStatusRunning   ImportJobStatus = "running"
+	ImportJobStatusCompleted ImportJobStatus = "completed"
+	ImportJobStatusFailed    ImportJobStatus = "failed"
+)
+
+func (e *ImportJobStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ImportJobStatus(s)
+	case string:
+		*e = ImportJobStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ImportJobStatus: %T", src)
+	}
+	return nil
+}
+
+type NullImportJobStatus struct {
+	ImportJobStatus ImportJobStatus `json:"import_job_status"`
+	Valid           bool            `json:"valid"` // Valid is true if ImportJobStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullImportJobStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ImportJobStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ImportJobStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullImportJobStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ImportJobStatus), nil
+}
+
+type RecurrenceMode string
+
+const (
+	RecurrenceModeCalendar        RecurrenceMode = "calendar"
+	RecurrenceModeAfterCompletion RecurrenceMode = "after_completion"
+)
+
+func (e *RecurrenceMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RecurrenceMode(s)
+	case string:
+		*e = RecurrenceMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RecurrenceMode: %T", src)
+	}
+	return nil
+}
+
+type NullRecurrenceMode struct {
+	RecurrenceMode RecurrenceMode `json:"recurrence_mode"`
+	Valid          bool           `json:"valid"` // Valid is true if RecurrenceMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRecurrenceMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.RecurrenceMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RecurrenceMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRecurrenceMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RecurrenceMode), nil
+}
+
+type TaskActivityAction string
+
+const (
+	TaskActivityActionCreated     TaskActivityAction = "created"
+	TaskActivityActionUpdated     TaskActivityAction = "updated"
+	TaskActivityActionRescheduled TaskActivityAction = "rescheduled"
+	TaskActivityActionCompleted   TaskActivityAction = "completed"
+	TaskActivityActionUncompleted TaskActivityAction = "uncompleted"
+	TaskActivityActionDeleted     TaskActivityAction = "deleted"
+)
+
+func (e *TaskActivityAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaskActivityAction(s)
+	case string:
+		*e = TaskActivityAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaskActivityAction: %T", src)
+	}
+	return nil
+}
+
+type NullTaskActivityAction struct {
+	TaskActivityAction TaskActivityAction `json:"task_activity_action"`
+	Valid              bool               `json:"valid"` // Valid is true if TaskActivityAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaskActivityAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaskActivityAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaskActivityAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaskActivityAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaskActivityAction), nil
+}
+
+type TaskPriority string
+
+const (
+	TaskPriorityNone   TaskPriority = "none"
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityMedium TaskPriority = "medium"
+	TaskPriorityHigh   TaskPriority = "high"
+)
+
+func (e *TaskPriority) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaskPriority(s)
+	case string:
+		*e = TaskPriority(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaskPriority: %T", src)
+	}
+	return nil
+}
+
+type NullTaskPriority struct {
+	TaskPriority TaskPriority `json:"task_priority"`
+	Valid        bool         `json:"valid"` // Valid is true if TaskPriority is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaskPriority) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaskPriority, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaskPriority.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaskPriority) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaskPriority), nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus `json:"webhook_delivery_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+type DeletedBlob struct {
+	StorageKey string           `json:"storage_key"`
+	DeletedAt  pgtype.Timestamp `json:"deleted_at"`
+}
+
+type ExportJob struct {
+	ID         int64            `json:"id"`
+	UserID     int32            `json:"user_id"`
+	Status     ExportJobStatus  `json:"status"`
+	FilePath   pgtype.Text      `json:"file_path"`
+	Error      pgtype.Text      `json:"error"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	FinishedAt pgtype.Timestamp `json:"finished_at"`
+}
+
 type Goal struct {
 	ID           int64             `json:"id"`
 	UserID       int32             `json:"user_id"`
@@ -64,17 +385,70 @@ type Goal struct {
 	CreatedAt    pgtype.Timestamp  `json:"created_at"`
 }
 
+type GoalInvitation struct {
+	ID        int64            `json:"id"`
+	GoalID    int32            `json:"goal_id"`
+	Email     string           `json:"email"`
+	Role      GoalMemberRole   `json:"role"`
+	InvitedBy int32            `json:"invited_by"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type GoalMember struct {
+	GoalID    int32            `json:"goal_id"`
+	UserID    int32            `json:"user_id"`
+	Role      GoalMemberRole   `json:"role"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type ImportJob struct {
+	ID             int64            `json:"id"`
+	UserID         int32            `json:"user_id"`
+	GoalID         int32            `json:"goal_id"`
+	Source         string           `json:"source"`
+	Status         ImportJobStatus  `json:"status"`
+	TotalItems     int32            `json:"total_items"`
+	ProcessedItems int32            `json:"processed_items"`
+	FailedItems    int32            `json:"failed_items"`
+	ItemErrors     []string         `json:"item_errors"`
+	Error          pgtype.Text      `json:"error"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	FinishedAt     pgtype.Timestamp `json:"finished_at"`
+}
+
+type Notification struct {
+	ID        int64            `json:"id"`
+	UserID    int32            `json:"user_id"`
+	TaskID    pgtype.Int4      `json:"task_id"`
+	Title     string           `json:"title"`
+	Body      string           `json:"body"`
+	ReadAt    pgtype.Timestamp `json:"read_at"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
 type RecurringTasksTemplate struct {
-	ID                int64            `json:"id"`
-	UserID            int32            `json:"user_id"`
-	GoalID            int32            `json:"goal_id"`
-	Title             string           `json:"title"`
-	ScheduledDatetime pgtype.Timestamp `json:"scheduled_datetime"`
-	HasTime           bool             `json:"has_time"`
-	DurationMinutes   int32            `json:"duration_minutes"`
-	RecurrenceRrule   string           `json:"recurrence_rrule"`
-	LastGeneratedDate pgtype.Date      `json:"last_generated_date"`
-	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	ID                     int64            `json:"id"`
+	UserID                 int32            `json:"user_id"`
+	GoalID                 int32            `json:"goal_id"`
+	Title                  string           `json:"title"`
+	ScheduledDatetime      pgtype.Timestamp `json:"scheduled_datetime"`
+	HasTime                bool             `json:"has_time"`
+	DurationMinutes        int32            `json:"duration_minutes"`
+	RecurrenceRrule        string           `json:"recurrence_rrule"`
+	LastGeneratedDate      pgtype.Date      `json:"last_generated_date"`
+	CreatedAt              pgtype.Timestamp `json:"created_at"`
+	Priority               TaskPriority     `json:"priority"`
+	Exdates                []pgtype.Date    `json:"exdates"`
+	Rdates                 []pgtype.Date    `json:"rdates"`
+	RecurrenceMode         RecurrenceMode   `json:"recurrence_mode"`
+	RecurrenceIntervalDays int32            `json:"recurrence_interval_days"`
+	AssigneeIds            []int32          `json:"assignee_ids"`
+	AssigneeRotation       int32            `json:"assignee_rotation"`
+}
+
+type RecurringTasksTemplateTag struct {
+	TemplateID int32 `json:"template_id"`
+	TagID      int32 `json:"tag_id"`
 }
 
 type RefreshToken struct {
@@ -85,24 +459,125 @@ type RefreshToken struct {
 	CreatedAt pgtype.Timestamp `json:"created_at"`
 }
 
-type Task struct {
+type Reminder struct {
 	ID                  int64            `json:"id"`
 	UserID              int32            `json:"user_id"`
-	GoalID              int32            `json:"goal_id"`
+	TaskID              pgtype.Int4      `json:"task_id"`
 	RecurringTemplateID pgtype.Int4      `json:"recurring_template_id"`
-	Title               string           `json:"title"`
-	IsDone              bool             `json:"is_done"`
-	ScheduledDate       pgtype.Date      `json:"scheduled_date"`
-	HasTime             bool             `json:"has_time"`
-	ScheduledTime       pgtype.Time      `json:"scheduled_time"`
-	DurationMinutes     pgtype.Int4      `json:"duration_minutes"`
-	RescheduleCount     int32            `json:"reschedule_count"`
+	OffsetMinutes       int32            `json:"offset_minutes"`
 	CreatedAt           pgtype.Timestamp `json:"created_at"`
 }
 
+type Tag struct {
+	ID        int64            `json:"id"`
+	UserID    int32            `json:"user_id"`
+	Title     string           `json:"title"`
+	Color     pgtype.Text      `json:"color"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type Task struct {
+	ID                    int64            `json:"id"`
+	UserID                int32            `json:"user_id"`
+	GoalID                int32            `json:"goal_id"`
+	RecurringTemplateID   pgtype.Int4      `json:"recurring_template_id"`
+	Title                 string           `json:"title"`
+	IsDone                bool             `json:"is_done"`
+	ScheduledDate         pgtype.Date      `json:"scheduled_date"`
+	HasTime               bool             `json:"has_time"`
+	ScheduledTime         pgtype.Time      `json:"scheduled_time"`
+	DurationMinutes       pgtype.Int4      `json:"duration_minutes"`
+	RescheduleCount       int32            `json:"reschedule_count"`
+	CreatedAt             pgtype.Timestamp `json:"created_at"`
+	Priority              TaskPriority     `json:"priority"`
+	IsRecurrenceException bool             `json:"is_recurrence_exception"`
+	CompletedAt           pgtype.Timestamp `json:"completed_at"`
+	AssigneeID            pgtype.Int4      `json:"assignee_id"`
+	Description           string           `json:"description"`
+}
+
+type TaskActivity struct {
+	ID        int64              `json:"id"`
+	TaskID    int32              `json:"task_id"`
+	Action    TaskActivityAction `json:"action"`
+	Changes   []byte             `json:"changes"`
+	CreatedAt pgtype.Timestamp   `json:"created_at"`
+}
+
+type TaskChecklistItem struct {
+	ID        int64            `json:"id"`
+	TaskID    int32            `json:"task_id"`
+	UserID    int32            `json:"user_id"`
+	Title     string           `json:"title"`
+	IsDone    bool             `json:"is_done"`
+	Position  int32            `json:"position"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type TaskAttachment struct {
+	ID          int64            `json:"id"`
+	TaskID      int32            `json:"task_id"`
+	UserID      int32            `json:"user_id"`
+	FileName    string           `json:"file_name"`
+	ContentType string           `json:"content_type"`
+	SizeBytes   int64            `json:"size_bytes"`
+	StorageKey  string           `json:"storage_key"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+}
+
+type TaskComment struct {
+	ID        int64            `json:"id"`
+	TaskID    int32            `json:"task_id"`
+	UserID    int32            `json:"user_id"`
+	Body      string           `json:"body"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
+type TaskTag struct {
+	TaskID int32 `json:"task_id"`
+	TagID  int32 `json:"tag_id"`
+}
+
 type User struct {
-	ID           int64            `json:"id"`
-	Email        string           `json:"email"`
-	PasswordHash string           `json:"password_hash"`
-	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	ID                 int64            `json:"id"`
+	Email              string           `json:"email"`
+	PasswordHash       string           `json:"password_hash"`
+	CreatedAt          pgtype.Timestamp `json:"created_at"`
+	TimeZone           string           `json:"time_zone"`
+	AutoRollover       bool             `json:"auto_rollover"`
+	CalendarTokenHash  pgtype.Text      `json:"calendar_token_hash"`
+	EmailNotifications bool             `json:"email_notifications"`
+	EmailAgenda        bool             `json:"email_agenda"`
+}
+
+type WeeklyReview struct {
+	ID        int64            `json:"id"`
+	UserID    int32            `json:"user_id"`
+	WeekStart pgtype.Date      `json:"week_start"`
+	Summary   []byte           `json:"summary"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type Webhook struct {
+	ID        int64            `json:"id"`
+	UserID    int32            `json:"user_id"`
+	Url       string           `json:"url"`
+	Secret    string           `json:"secret"`
+	Events    []string         `json:"events"`
+	IsActive  bool             `json:"is_active"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             int64                 `json:"id"`
+	WebhookID      int32                 `json:"webhook_id"`
+	Event          string                `json:"event"`
+	Payload        []byte                `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int32                 `json:"attempts"`
+	ResponseStatus pgtype.Int4           `json:"response_status"`
+	Error          pgtype.Text           `json:"error"`
+	CreatedAt      pgtype.Timestamp      `json:"created_at"`
+	DeliveredAt    pgtype.Timestamp      `json:"delivered_at"`
 }
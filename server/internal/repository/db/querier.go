@@ -11,36 +11,136 @@ import (
 )
 
 type Querier interface {
-	CountCompletedTasksForToday(ctx context.Context, userID int32) (CountCompletedTasksForTodayRow, error)
+	AddExdateToRecurringTasksTemplateByID(ctx context.Context, arg AddExdateToRecurringTasksTemplateByIDParams) error
+	CountCompletedTasksForToday(ctx context.Context, arg CountCompletedTasksForTodayParams) (CountCompletedTasksForTodayRow, error)
+	CountOpenTasksByRecurringTasksTemplateID(ctx context.Context, recurringTemplateID pgtype.Int4) (int32, error)
+	CountTagsByIDs(ctx context.Context, arg CountTagsByIDsParams) (int32, error)
+	CountTaskAttachmentsByTaskID(ctx context.Context, taskID int32) (int64, error)
+	CountTaskChecklistItemsByTaskIDs(ctx context.Context, taskIds []int32) ([]CountTaskChecklistItemsByTaskIDsRow, error)
+	CreateExportJob(ctx context.Context, userID int32) (ExportJob, error)
 	CreateGoal(ctx context.Context, arg CreateGoalParams) (Goal, error)
+	CreateGoalInvitation(ctx context.Context, arg CreateGoalInvitationParams) (GoalInvitation, error)
+	CreateGoalMember(ctx context.Context, arg CreateGoalMemberParams) error
+	CreateImportJob(ctx context.Context, arg CreateImportJobParams) (ImportJob, error)
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error)
 	CreateRecurringTasksTemplate(ctx context.Context, arg CreateRecurringTasksTemplateParams) (RecurringTasksTemplate, error)
+	CreateRecurringTasksTemplateTags(ctx context.Context, arg CreateRecurringTasksTemplateTagsParams) error
 	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error)
+	CreateReminder(ctx context.Context, arg CreateReminderParams) (Reminder, error)
+	CreateTag(ctx context.Context, arg CreateTagParams) (Tag, error)
 	CreateTask(ctx context.Context, arg CreateTaskParams) (Task, error)
+	CreateTaskActivity(ctx context.Context, arg CreateTaskActivityParams) error
+	CreateTaskAttachment(ctx context.Context, arg CreateTaskAttachmentParams) (TaskAttachment, error)
+	CreateTaskChecklistItem(ctx context.Context, arg CreateTaskChecklistItemParams) (TaskChecklistItem, error)
+	CreateTaskComment(ctx context.Context, arg CreateTaskCommentParams) (TaskComment, error)
+	CreateTaskDependency(ctx context.Context, arg CreateTaskDependencyParams) error
+	CreateTaskTags(ctx context.Context, arg CreateTaskTagsParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	DeleteDeletedBlob(ctx context.Context, storageKey string) error
 	DeleteFutureTasksByRecurringTasksTemplateID(ctx context.Context, recurringTemplateID pgtype.Int4) error
 	DeleteGoalByID(ctx context.Context, arg DeleteGoalByIDParams) error
+	DeleteGoalInvitationByID(ctx context.Context, arg DeleteGoalInvitationByIDParams) (int64, error)
+	DeleteGoalMemberByIDs(ctx context.Context, arg DeleteGoalMemberByIDsParams) (int64, error)
 	DeleteRecurringTasksTemplateByID(ctx context.Context, arg DeleteRecurringTasksTemplateByIDParams) error
+	DeleteRecurringTasksTemplateTagsByTemplateID(ctx context.Context, templateID int32) error
 	DeleteRefreshTokenByUserID(ctx context.Context, userID int32) error
-	DeleteTaskByID(ctx context.Context, arg DeleteTaskByIDParams) error
-	GetGoalByID(ctx context.Context, arg GetGoalByIDParams) (Goal, error)
+	DeleteReminderByID(ctx context.Context, arg DeleteReminderByIDParams) error
+	DeleteTagByID(ctx context.Context, arg DeleteTagByIDParams) error
+	DeleteTaskAttachmentByID(ctx context.Context, arg DeleteTaskAttachmentByIDParams) (int64, error)
+	DeleteTaskByID(ctx context.Context, arg DeleteTaskByIDParams) (int64, error)
+	DeleteTaskChecklistItemByID(ctx context.Context, arg DeleteTaskChecklistItemByIDParams) error
+	DeleteTaskCommentByID(ctx context.Context, arg DeleteTaskCommentByIDParams) (int64, error)
+	DeleteTaskDependency(ctx context.Context, arg DeleteTaskDependencyParams) (int64, error)
+	DeleteTaskTagsByTaskID(ctx context.Context, taskID int32) error
+	DeleteTasksFromDateByRecurringTasksTemplateID(ctx context.Context, arg DeleteTasksFromDateByRecurringTasksTemplateIDParams) error
+	DeleteWebhookByID(ctx context.Context, arg DeleteWebhookByIDParams) error
+	FinishExportJobByID(ctx context.Context, arg FinishExportJobByIDParams) error
+	FinishImportJobByID(ctx context.Context, arg FinishImportJobByIDParams) error
+	GetExportJobByID(ctx context.Context, arg GetExportJobByIDParams) (ExportJob, error)
+	GetGoalByID(ctx context.Context, arg GetGoalByIDParams) (GetGoalByIDRow, error)
+	GetGoalInvitationByIDAndEmail(ctx context.Context, arg GetGoalInvitationByIDAndEmailParams) (GoalInvitation, error)
+	GetImportJobByID(ctx context.Context, arg GetImportJobByIDParams) (ImportJob, error)
 	GetRecurringTasksTemplateByID(ctx context.Context, arg GetRecurringTasksTemplateByIDParams) (RecurringTasksTemplate, error)
 	GetRefreshTokenByUserID(ctx context.Context, userID int32) (RefreshToken, error)
+	GetReminderByID(ctx context.Context, arg GetReminderByIDParams) (Reminder, error)
+	GetRoleInGoalMemberByIDs(ctx context.Context, arg GetRoleInGoalMemberByIDsParams) (GoalMemberRole, error)
+	GetTagByID(ctx context.Context, arg GetTagByIDParams) (Tag, error)
+	GetTaskAttachmentByID(ctx context.Context, arg GetTaskAttachmentByIDParams) (TaskAttachment, error)
 	GetTaskByID(ctx context.Context, arg GetTaskByIDParams) (Task, error)
+	GetTaskChecklistItemByID(ctx context.Context, arg GetTaskChecklistItemByIDParams) (TaskChecklistItem, error)
+	GetUserByCalendarTokenHash(ctx context.Context, calendarTokenHash pgtype.Text) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id int64) (User, error)
-	ListGoals(ctx context.Context, userID int32) ([]Goal, error)
-	ListGoalsByIsArchived(ctx context.Context, arg ListGoalsByIsArchivedParams) ([]Goal, error)
+	GetWebhookByID(ctx context.Context, arg GetWebhookByIDParams) (Webhook, error)
+	GetWebhookDeliveryForSendingByID(ctx context.Context, id int64) (GetWebhookDeliveryForSendingByIDRow, error)
+	GetWeeklyReviewByWeekStart(ctx context.Context, arg GetWeeklyReviewByWeekStartParams) (WeeklyReview, error)
+	HasTaskDependencyPath(ctx context.Context, arg HasTaskDependencyPathParams) (bool, error)
+	IncrementAssigneeRotationInRecurringTasksTemplateByID(ctx context.Context, id int64) (int32, error)
+	ListActiveWebhooksByEvent(ctx context.Context, arg ListActiveWebhooksByEventParams) ([]Webhook, error)
+	ListAgendaRecipients(ctx context.Context) ([]ListAgendaRecipientsRow, error)
+	ListAssignedTasks(ctx context.Context, userID int32) ([]Task, error)
+	ListBlockedTaskIDs(ctx context.Context, taskIds []int32) ([]int32, error)
+	ListCompletedAtByGoalID(ctx context.Context, goalID int32) ([]pgtype.Timestamp, error)
+	ListDeletedBlobs(ctx context.Context, limit int32) ([]DeletedBlob, error)
+	ListEffectiveRemindersByTaskID(ctx context.Context, arg ListEffectiveRemindersByTaskIDParams) ([]Reminder, error)
+	ListGoalInvitationsByEmail(ctx context.Context, email string) ([]ListGoalInvitationsByEmailRow, error)
+	ListGoalInvitationsByGoalID(ctx context.Context, goalID int32) ([]GoalInvitation, error)
+	ListGoalMembersByGoalID(ctx context.Context, goalID int32) ([]ListGoalMembersByGoalIDRow, error)
+	ListGoalStatisticsByDateRange(ctx context.Context, arg ListGoalStatisticsByDateRangeParams) ([]ListGoalStatisticsByDateRangeRow, error)
+	ListGoals(ctx context.Context, userID int32) ([]ListGoalsRow, error)
+	ListGoalsByIsArchived(ctx context.Context, arg ListGoalsByIsArchivedParams) ([]ListGoalsByIsArchivedRow, error)
 	ListInboxTasks(ctx context.Context, userID int32) ([]Task, error)
+	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error)
+	ListOccurrencesByRecurringTasksTemplateID(ctx context.Context, arg ListOccurrencesByRecurringTasksTemplateIDParams) ([]ListOccurrencesByRecurringTasksTemplateIDRow, error)
+	ListOverdueTasks(ctx context.Context, arg ListOverdueTasksParams) ([]Task, error)
+	ListRecurringTasksByDateRange(ctx context.Context, arg ListRecurringTasksByDateRangeParams) ([]Task, error)
 	ListRecurringTasksTemplates(ctx context.Context, userID int32) ([]RecurringTasksTemplate, error)
 	ListRecurringTasksTemplatesDueForGeneration(ctx context.Context) ([]RecurringTasksTemplate, error)
-	ListTasks(ctx context.Context, userID int32) ([]Task, error)
+	ListRemindersByRecurringTemplateID(ctx context.Context, arg ListRemindersByRecurringTemplateIDParams) ([]Reminder, error)
+	ListRemindersByTaskID(ctx context.Context, arg ListRemindersByTaskIDParams) ([]Reminder, error)
+	ListTagIDsByRecurringTasksTemplateIDs(ctx context.Context, templateIds []int32) ([]ListTagIDsByRecurringTasksTemplateIDsRow, error)
+	ListTags(ctx context.Context, userID int32) ([]Tag, error)
+	ListTagsByTaskIDs(ctx context.Context, taskIds []int32) ([]ListTagsByTaskIDsRow, error)
+	ListTaskActivityByTaskID(ctx context.Context, taskID int32) ([]TaskActivity, error)
+	ListTaskAttachmentsByTaskID(ctx context.Context, taskID int32) ([]TaskAttachment, error)
+	ListTaskBlockersByTaskID(ctx context.Context, taskID int32) ([]Task, error)
+	ListTaskChecklistItemsByTaskID(ctx context.Context, taskID int32) ([]TaskChecklistItem, error)
+	ListTaskCommentsByTaskID(ctx context.Context, taskID int32) ([]TaskComment, error)
 	ListTasksByDateRange(ctx context.Context, arg ListTasksByDateRangeParams) ([]Task, error)
-	ListTasksByGoalID(ctx context.Context, arg ListTasksByGoalIDParams) ([]Task, error)
+	ListTasksPage(ctx context.Context, arg ListTasksPageParams) ([]Task, error)
+	ListUpcomingTasksByRecurringTemplateID(ctx context.Context, arg ListUpcomingTasksByRecurringTemplateIDParams) ([]Task, error)
+	ListUserTimeZones(ctx context.Context) ([]ListUserTimeZonesRow, error)
+	ListWebhookDeliveriesByWebhookID(ctx context.Context, webhookID int32) ([]WebhookDelivery, error)
+	ListWebhooks(ctx context.Context, userID int32) ([]Webhook, error)
+	LockTaskDependencies(ctx context.Context) error
+	MarkNotificationAsReadByID(ctx context.Context, arg MarkNotificationAsReadByIDParams) (Notification, error)
+	RemoveAssigneeFromRecurringTasksTemplatesByGoalID(ctx context.Context, arg RemoveAssigneeFromRecurringTasksTemplatesByGoalIDParams) error
+	ResetLastGeneratedDateInRecurringTasksTemplateByID(ctx context.Context, id int64) (RecurringTasksTemplate, error)
+	RollOverOverdueTasks(ctx context.Context) (int64, error)
+	SearchTasks(ctx context.Context, arg SearchTasksParams) ([]Task, error)
+	StartExportJobByID(ctx context.Context, id int64) error
+	StartImportJobByID(ctx context.Context, arg StartImportJobByIDParams) error
+	UnassignTasksByGoalID(ctx context.Context, arg UnassignTasksByGoalIDParams) error
+	UpdateAttemptInWebhookDeliveryByID(ctx context.Context, arg UpdateAttemptInWebhookDeliveryByIDParams) error
+	UpdateCalendarTokenHashInUserByID(ctx context.Context, arg UpdateCalendarTokenHashInUserByIDParams) (User, error)
 	UpdateGoalByID(ctx context.Context, arg UpdateGoalByIDParams) (Goal, error)
 	UpdateIsDoneInTaskByID(ctx context.Context, arg UpdateIsDoneInTaskByIDParams) (Task, error)
+	UpdateIsDoneInTaskChecklistItemsByTaskID(ctx context.Context, arg UpdateIsDoneInTaskChecklistItemsByTaskIDParams) error
 	UpdateLastGeneratedDateInRecurringTasksTemplateByID(ctx context.Context, arg UpdateLastGeneratedDateInRecurringTasksTemplateByIDParams) error
+	UpdateProgressInImportJobByID(ctx context.Context, arg UpdateProgressInImportJobByIDParams) error
+	UpdateRecurrenceRruleInRecurringTasksTemplateByID(ctx context.Context, arg UpdateRecurrenceRruleInRecurringTasksTemplateByIDParams) error
 	UpdateRecurringTasksTemplateByID(ctx context.Context, arg UpdateRecurringTasksTemplateByIDParams) (RecurringTasksTemplate, error)
+	UpdateRoleInGoalMemberByIDs(ctx context.Context, arg UpdateRoleInGoalMemberByIDsParams) (GoalMember, error)
+	UpdateSettingsInUserByID(ctx context.Context, arg UpdateSettingsInUserByIDParams) (User, error)
+	UpdateTagByID(ctx context.Context, arg UpdateTagByIDParams) (Tag, error)
 	UpdateTaskByID(ctx context.Context, arg UpdateTaskByIDParams) (Task, error)
+	UpdateTaskChecklistItemByID(ctx context.Context, arg UpdateTaskChecklistItemByIDParams) (TaskChecklistItem, error)
+	UpdateTaskCommentByID(ctx context.Context, arg UpdateTaskCommentByIDParams) (TaskComment, error)
+	UpdateUpcomingTasksByRecurringTasksTemplateID(ctx context.Context, arg UpdateUpcomingTasksByRecurringTasksTemplateIDParams) ([]Task, error)
+	UpdateWebhookByID(ctx context.Context, arg UpdateWebhookByIDParams) (Webhook, error)
+	UpsertWeeklyReview(ctx context.Context, arg UpsertWeeklyReviewParams) (WeeklyReview, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -11,23 +11,46 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addExdateToRecurringTasksTemplateByID = `-- name: AddExdateToRecurringTasksTemplateByID :exec
+UPDATE recurring_tasks_templates
+SET exdates = array_append(exdates, $1::date)
+WHERE id = $2 AND user_id = $3 AND NOT ($1::date = ANY(exdates))
+`
+
+type AddExdateToRecurringTasksTemplateByIDParams struct {
+	Exdate pgtype.Date `json:"exdate"`
+	ID     int64       `json:"id"`
+	UserID int32       `json:"user_id"`
+}
+
+func (q *Queries) AddExdateToRecurringTasksTemplateByID(ctx context.Context, arg AddExdateToRecurringTasksTemplateByIDParams) error {
+	_, err := q.db.Exec(ctx, addExdateToRecurringTasksTemplateByID, arg.Exdate, arg.ID, arg.UserID)
+	return err
+}
+
 const createRecurringTasksTemplate = `-- name: CreateRecurringTasksTemplate :one
 INSERT INTO recurring_tasks_templates (
-    user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule
+    user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, priority, exdates, rdates, recurrence_mode, recurrence_interval_days, assignee_ids
 ) VALUES (
-             $1, $2, $3, $4, $5, $6, $7
+             $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
          )
-    RETURNING id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at
+    RETURNING id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at, priority, exdates, rdates, recurrence_mode, recurrence_interval_days, assignee_ids, assignee_rotation
 `
 
 type CreateRecurringTasksTemplateParams struct {
-	UserID            int32            `json:"user_id"`
-	GoalID            int32            `json:"goal_id"`
-	Title             string           `json:"title"`
-	ScheduledDatetime pgtype.Timestamp `json:"scheduled_datetime"`
-	HasTime           bool             `json:"has_time"`
-	DurationMinutes   int32            `json:"duration_minutes"`
-	RecurrenceRrule   string           `json:"recurrence_rrule"`
+	UserID                 int32            `json:"user_id"`
+	GoalID                 int32            `json:"goal_id"`
+	Title                  string           `json:"title"`
+	ScheduledDatetime      pgtype.Timestamp `json:"scheduled_datetime"`
+	HasTime                bool             `json:"has_time"`
+	DurationMinutes        int32            `json:"duration_minutes"`
+	RecurrenceRrule        string           `json:"recurrence_rrule"`
+	Priority               TaskPriority     `json:"priority"`
+	Exdates                []pgtype.Date    `json:"exdates"`
+	Rdates                 []pgtype.Date    `json:"rdates"`
+	RecurrenceMode         RecurrenceMode   `json:"recurrence_mode"`
+	RecurrenceIntervalDays int32            `json:"recurrence_interval_days"`
+	AssigneeIds            []int32          `json:"assignee_ids"`
 }
 
 func (q *Queries) CreateRecurringTasksTemplate(ctx context.Context, arg CreateRecurringTasksTemplateParams) (RecurringTasksTemplate, error) {
@@ -39,6 +62,12 @@ func (q *Queries) CreateRecurringTasksTemplate(ctx context.Context, arg CreateRe
 		arg.HasTime,
 		arg.DurationMinutes,
 		arg.RecurrenceRrule,
+		arg.Priority,
+		arg.Exdates,
+		arg.Rdates,
+		arg.RecurrenceMode,
+		arg.RecurrenceIntervalDays,
+		arg.AssigneeIds,
 	)
 	var i RecurringTasksTemplate
 	err := row.Scan(
@@ -52,6 +81,13 @@ func (q *Queries) CreateRecurringTasksTemplate(ctx context.Context, arg CreateRe
 		&i.RecurrenceRrule,
 		&i.LastGeneratedDate,
 		&i.CreatedAt,
+		&i.Priority,
+		&i.Exdates,
+		&i.Rdates,
+		&i.RecurrenceMode,
+		&i.RecurrenceIntervalDays,
+		&i.AssigneeIds,
+		&i.AssigneeRotation,
 	)
 	return i, err
 }
@@ -72,8 +108,8 @@ func (q *Queries) DeleteRecurringTasksTemplateByID(ctx context.Context, arg Dele
 }
 
 const getRecurringTasksTemplateByID = `-- name: GetRecurringTasksTemplateByID :one
-SELECT id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at FROM recurring_tasks_templates
-WHERE id = $1 AND user_id = $2 LIMIT 1
+SELECT id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at, priority, exdates, rdates, recurrence_mode, recurrence_interval_days, assignee_ids, assignee_rotation FROM recurring_tasks_templates
+WHERE id = $1 AND (user_id = $2 OR goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = $2)) LIMIT 1
 `
 
 type GetRecurringTasksTemplateByIDParams struct {
@@ -95,12 +131,33 @@ func (q *Queries) GetRecurringTasksTemplateByID(ctx context.Context, arg GetRecu
 		&i.RecurrenceRrule,
 		&i.LastGeneratedDate,
 		&i.CreatedAt,
+		&i.Priority,
+		&i.Exdates,
+		&i.Rdates,
+		&i.RecurrenceMode,
+		&i.RecurrenceIntervalDays,
+		&i.AssigneeIds,
+		&i.AssigneeRotation,
 	)
 	return i, err
 }
 
+const incrementAssigneeRotationInRecurringTasksTemplateByID = `-- name: IncrementAssigneeRotationInRecurringTasksTemplateByID :one
+UPDATE recurring_tasks_templates
+SET assignee_rotation = assignee_rotation + 1
+WHERE id = $1
+RETURNING assignee_rotation
+`
+
+func (q *Queries) IncrementAssigneeRotationInRecurringTasksTemplateByID(ctx context.Context, id int64) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementAssigneeRotationInRecurringTasksTemplateByID, id)
+	var assignee_rotation int32
+	err := row.Scan(&assignee_rotation)
+	return assignee_rotation, err
+}
+
 const listRecurringTasksTemplates = `-- name: ListRecurringTasksTemplates :many
-SELECT id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at FROM recurring_tasks_templates
+SELECT id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at, priority, exdates, rdates, recurrence_mode, recurrence_interval_days, assignee_ids, assignee_rotation FROM recurring_tasks_templates
 WHERE user_id = $1
 ORDER BY id
 `
@@ -125,6 +182,13 @@ func (q *Queries) ListRecurringTasksTemplates(ctx context.Context, userID int32)
 			&i.RecurrenceRrule,
 			&i.LastGeneratedDate,
 			&i.CreatedAt,
+			&i.Priority,
+			&i.Exdates,
+			&i.Rdates,
+			&i.RecurrenceMode,
+			&i.RecurrenceIntervalDays,
+			&i.AssigneeIds,
+			&i.AssigneeRotation,
 		); err != nil {
 			return nil, err
 		}
@@ -137,8 +201,10 @@ func (q *Queries) ListRecurringTasksTemplates(ctx context.Context, userID int32)
 }
 
 const listRecurringTasksTemplatesDueForGeneration = `-- name: ListRecurringTasksTemplatesDueForGeneration :many
-SELECT id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at FROM recurring_tasks_templates
-WHERE last_generated_date < (current_date + interval '1 month')
+SELECT recurring_tasks_templates.id, recurring_tasks_templates.user_id, recurring_tasks_templates.goal_id, recurring_tasks_templates.title, recurring_tasks_templates.scheduled_datetime, recurring_tasks_templates.has_time, recurring_tasks_templates.duration_minutes, recurring_tasks_templates.recurrence_rrule, recurring_tasks_templates.last_generated_date, recurring_tasks_templates.created_at, recurring_tasks_templates.priority, recurring_tasks_templates.exdates, recurring_tasks_templates.rdates, recurring_tasks_templates.recurrence_mode, recurring_tasks_templates.recurrence_interval_days, recurring_tasks_templates.assignee_ids, recurring_tasks_templates.assignee_rotation FROM recurring_tasks_templates
+JOIN users ON users.id = recurring_tasks_templates.user_id
+WHERE recurring_tasks_templates.last_generated_date < ((now() AT TIME ZONE users.time_zone)::date + interval '1 month')
+  AND recurring_tasks_templates.recurrence_mode = 'calendar'
 `
 
 func (q *Queries) ListRecurringTasksTemplatesDueForGeneration(ctx context.Context) ([]RecurringTasksTemplate, error) {
@@ -161,6 +227,13 @@ func (q *Queries) ListRecurringTasksTemplatesDueForGeneration(ctx context.Contex
 			&i.RecurrenceRrule,
 			&i.LastGeneratedDate,
 			&i.CreatedAt,
+			&i.Priority,
+			&i.Exdates,
+			&i.Rdates,
+			&i.RecurrenceMode,
+			&i.RecurrenceIntervalDays,
+			&i.AssigneeIds,
+			&i.AssigneeRotation,
 		); err != nil {
 			return nil, err
 		}
@@ -172,6 +245,55 @@ func (q *Queries) ListRecurringTasksTemplatesDueForGeneration(ctx context.Contex
 	return items, nil
 }
 
+const removeAssigneeFromRecurringTasksTemplatesByGoalID = `-- name: RemoveAssigneeFromRecurringTasksTemplatesByGoalID :exec
+UPDATE recurring_tasks_templates
+SET assignee_ids = array_remove(assignee_ids, $1::int)
+WHERE goal_id = $2 AND $1::int = ANY(assignee_ids)
+`
+
+type RemoveAssigneeFromRecurringTasksTemplatesByGoalIDParams struct {
+	AssigneeID int32 `json:"assignee_id"`
+	GoalID     int32 `json:"goal_id"`
+}
+
+func (q *Queries) RemoveAssigneeFromRecurringTasksTemplatesByGoalID(ctx context.Context, arg RemoveAssigneeFromRecurringTasksTemplatesByGoalIDParams) error {
+	_, err := q.db.Exec(ctx, removeAssigneeFromRecurringTasksTemplatesByGoalID, arg.AssigneeID, arg.GoalID)
+	return err
+}
+
+const resetLastGeneratedDateInRecurringTasksTemplateByID = `-- name: ResetLastGeneratedDateInRecurringTasksTemplateByID :one
+UPDATE recurring_tasks_templates
+SET last_generated_date = LEAST(recurring_tasks_templates.last_generated_date, (now() AT TIME ZONE users.time_zone)::date)
+FROM users
+WHERE recurring_tasks_templates.user_id = users.id AND recurring_tasks_templates.id = $1
+RETURNING recurring_tasks_templates.id, recurring_tasks_templates.user_id, recurring_tasks_templates.goal_id, recurring_tasks_templates.title, recurring_tasks_templates.scheduled_datetime, recurring_tasks_templates.has_time, recurring_tasks_templates.duration_minutes, recurring_tasks_templates.recurrence_rrule, recurring_tasks_templates.last_generated_date, recurring_tasks_templates.created_at, recurring_tasks_templates.priority, recurring_tasks_templates.exdates, recurring_tasks_templates.rdates, recurring_tasks_templates.recurrence_mode, recurring_tasks_templates.recurrence_interval_days, recurring_tasks_templates.assignee_ids, recurring_tasks_templates.assignee_rotation
+`
+
+func (q *Queries) ResetLastGeneratedDateInRecurringTasksTemplateByID(ctx context.Context, id int64) (RecurringTasksTemplate, error) {
+	row := q.db.QueryRow(ctx, resetLastGeneratedDateInRecurringTasksTemplateByID, id)
+	var i RecurringTasksTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.GoalID,
+		&i.Title,
+		&i.ScheduledDatetime,
+		&i.HasTime,
+		&i.DurationMinutes,
+		&i.RecurrenceRrule,
+		&i.LastGeneratedDate,
+		&i.CreatedAt,
+		&i.Priority,
+		&i.Exdates,
+		&i.Rdates,
+		&i.RecurrenceMode,
+		&i.RecurrenceIntervalDays,
+		&i.AssigneeIds,
+		&i.AssigneeRotation,
+	)
+	return i, err
+}
+
 const updateLastGeneratedDateInRecurringTasksTemplateByID = `-- name: UpdateLastGeneratedDateInRecurringTasksTemplateByID :exec
 UPDATE recurring_tasks_templates
 SET last_generated_date = $2
@@ -188,6 +310,23 @@ func (q *Queries) UpdateLastGeneratedDateInRecurringTasksTemplateByID(ctx contex
 	return err
 }
 
+const updateRecurrenceRruleInRecurringTasksTemplateByID = `-- name: UpdateRecurrenceRruleInRecurringTasksTemplateByID :exec
+UPDATE recurring_tasks_templates
+SET recurrence_rrule = $3
+WHERE id = $1 AND user_id = $2
+`
+
+type UpdateRecurrenceRruleInRecurringTasksTemplateByIDParams struct {
+	ID              int64  `json:"id"`
+	UserID          int32  `json:"user_id"`
+	RecurrenceRrule string `json:"recurrence_rrule"`
+}
+
+func (q *Queries) UpdateRecurrenceRruleInRecurringTasksTemplateByID(ctx context.Context, arg UpdateRecurrenceRruleInRecurringTasksTemplateByIDParams) error {
+	_, err := q.db.Exec(ctx, updateRecurrenceRruleInRecurringTasksTemplateByID, arg.ID, arg.UserID, arg.RecurrenceRrule)
+	return err
+}
+
 const updateRecurringTasksTemplateByID = `-- name: UpdateRecurringTasksTemplateByID :one
 UPDATE recurring_tasks_templates
 SET
@@ -196,20 +335,30 @@ SET
     scheduled_datetime = $5,
     has_time = $6,
     duration_minutes = $7,
-    recurrence_rrule = $8
+    recurrence_rrule = $8,
+    priority = $9,
+    exdates = $10,
+    rdates = $11,
+    recurrence_interval_days = $12,
+    assignee_ids = $13
 WHERE id = $1 AND user_id = $2
-RETURNING id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at
+RETURNING id, user_id, goal_id, title, scheduled_datetime, has_time, duration_minutes, recurrence_rrule, last_generated_date, created_at, priority, exdates, rdates, recurrence_mode, recurrence_interval_days, assignee_ids, assignee_rotation
 `
 
 type UpdateRecurringTasksTemplateByIDParams struct {
-	ID                int64            `json:"id"`
-	UserID            int32            `json:"user_id"`
-	GoalID            int32            `json:"goal_id"`
-	Title             string           `json:"title"`
-	ScheduledDatetime pgtype.Timestamp `json:"scheduled_datetime"`
-	HasTime           bool             `json:"has_time"`
-	DurationMinutes   int32            `json:"duration_minutes"`
-	RecurrenceRrule   string           `json:"recurrence_rrule"`
+	ID                     int64            `json:"id"`
+	UserID                 int32            `json:"user_id"`
+	GoalID                 int32            `json:"goal_id"`
+	Title                  string           `json:"title"`
+	ScheduledDatetime      pgtype.Timestamp `json:"scheduled_datetime"`
+	HasTime                bool             `json:"has_time"`
+	DurationMinutes        int32            `json:"duration_minutes"`
+	RecurrenceRrule        string           `json:"recurrence_rrule"`
+	Priority               TaskPriority     `json:"priority"`
+	Exdates                []pgtype.Date    `json:"exdates"`
+	Rdates                 []pgtype.Date    `json:"rdates"`
+	RecurrenceIntervalDays int32            `json:"recurrence_interval_days"`
+	AssigneeIds            []int32          `json:"assignee_ids"`
 }
 
 func (q *Queries) UpdateRecurringTasksTemplateByID(ctx context.Context, arg UpdateRecurringTasksTemplateByIDParams) (RecurringTasksTemplate, error) {
@@ -222,6 +371,11 @@ func (q *Queries) UpdateRecurringTasksTemplateByID(ctx context.Context, arg Upda
 		arg.HasTime,
 		arg.DurationMinutes,
 		arg.RecurrenceRrule,
+		arg.Priority,
+		arg.Exdates,
+		arg.Rdates,
+		arg.RecurrenceIntervalDays,
+		arg.AssigneeIds,
 	)
 	var i RecurringTasksTemplate
 	err := row.Scan(
@@ -235,6 +389,13 @@ func (q *Queries) UpdateRecurringTasksTemplateByID(ctx context.Context, arg Upda
 		&i.RecurrenceRrule,
 		&i.LastGeneratedDate,
 		&i.CreatedAt,
+		&i.Priority,
+		&i.Exdates,
+		&i.Rdates,
+		&i.RecurrenceMode,
+		&i.RecurrenceIntervalDays,
+		&i.AssigneeIds,
+		&i.AssigneeRotation,
 	)
 	return i, err
 }
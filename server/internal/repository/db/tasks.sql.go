@@ -13,42 +13,62 @@ import (
 
 const countCompletedTasksForToday = `-- name: CountCompletedTasksForToday :one
 SELECT
-    count(*) FILTER (WHERE scheduled_date = current_date)::int AS total_today,
-    count(*) FILTER (WHERE scheduled_date = current_date AND is_done = true)::int AS completed_today
+    count(*)::int AS total_today,
+    count(*) FILTER (WHERE is_done = true)::int AS completed_today
 FROM tasks
-WHERE user_id = $1
+WHERE user_id = $1 AND scheduled_date = $2
 `
 
+type CountCompletedTasksForTodayParams struct {
+	UserID        int32       `json:"user_id"`
+	ScheduledDate pgtype.Date `json:"scheduled_date"`
+}
+
 type CountCompletedTasksForTodayRow struct {
 	TotalToday     int32 `json:"total_today"`
 	CompletedToday int32 `json:"completed_today"`
 }
 
-func (q *Queries) CountCompletedTasksForToday(ctx context.Context, userID int32) (CountCompletedTasksForTodayRow, error) {
-	row := q.db.QueryRow(ctx, countCompletedTasksForToday, userID)
+func (q *Queries) CountCompletedTasksForToday(ctx context.Context, arg CountCompletedTasksForTodayParams) (CountCompletedTasksForTodayRow, error) {
+	row := q.db.QueryRow(ctx, countCompletedTasksForToday, arg.UserID, arg.ScheduledDate)
 	var i CountCompletedTasksForTodayRow
 	err := row.Scan(&i.TotalToday, &i.CompletedToday)
 	return i, err
 }
 
+const countOpenTasksByRecurringTasksTemplateID = `-- name: CountOpenTasksByRecurringTasksTemplateID :one
+SELECT count(*)::int FROM tasks
+WHERE recurring_template_id = $1 AND is_done = false
+`
+
+func (q *Queries) CountOpenTasksByRecurringTasksTemplateID(ctx context.Context, recurringTemplateID pgtype.Int4) (int32, error) {
+	row := q.db.QueryRow(ctx, countOpenTasksByRecurringTasksTemplateID, recurringTemplateID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const createTask = `-- name: CreateTask :one
 INSERT INTO tasks (
-    user_id, goal_id, recurring_template_id, title, scheduled_date, has_time, scheduled_time, duration_minutes
+    user_id, goal_id, recurring_template_id, title, scheduled_date, has_time, scheduled_time, duration_minutes, priority, assignee_id, description
 ) VALUES (
-             $1, $2, $3, $4, $5, $6, $7, $8
+             $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
          )
-    RETURNING id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at
+    RETURNING id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description
 `
 
 type CreateTaskParams struct {
-	UserID              int32       `json:"user_id"`
-	GoalID              int32       `json:"goal_id"`
-	RecurringTemplateID pgtype.Int4 `json:"recurring_template_id"`
-	Title               string      `json:"title"`
-	ScheduledDate       pgtype.Date `json:"scheduled_date"`
-	HasTime             bool        `json:"has_time"`
-	ScheduledTime       pgtype.Time `json:"scheduled_time"`
-	DurationMinutes     pgtype.Int4 `json:"duration_minutes"`
+	UserID              int32        `json:"user_id"`
+	GoalID              int32        `json:"goal_id"`
+	RecurringTemplateID pgtype.Int4  `json:"recurring_template_id"`
+	Title               string       `json:"title"`
+	ScheduledDate       pgtype.Date  `json:"scheduled_date"`
+	HasTime             bool         `json:"has_time"`
+	ScheduledTime       pgtype.Time  `json:"scheduled_time"`
+	DurationMinutes     pgtype.Int4  `json:"duration_minutes"`
+	Priority            TaskPriority `json:"priority"`
+	AssigneeID          pgtype.Int4  `json:"assignee_id"`
+	Description         string       `json:"description"`
 }
 
 func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, error) {
@@ -61,6 +81,9 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, e
 		arg.HasTime,
 		arg.ScheduledTime,
 		arg.DurationMinutes,
+		arg.Priority,
+		arg.AssigneeID,
+		arg.Description,
 	)
 	var i Task
 	err := row.Scan(
@@ -76,13 +99,23 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, e
 		&i.DurationMinutes,
 		&i.RescheduleCount,
 		&i.CreatedAt,
+		&i.Priority,
+		&i.IsRecurrenceException,
+		&i.CompletedAt,
+		&i.AssigneeID,
+		&i.Description,
 	)
 	return i, err
 }
 
 const deleteFutureTasksByRecurringTasksTemplateID = `-- name: DeleteFutureTasksByRecurringTasksTemplateID :exec
 DELETE FROM tasks
-WHERE recurring_template_id = $1 AND scheduled_date > current_date AND is_done = false
+USING users
+WHERE tasks.user_id = users.id
+  AND tasks.recurring_template_id = $1
+  AND tasks.scheduled_date > (now() AT TIME ZONE users.time_zone)::date
+  AND tasks.is_done = false
+  AND tasks.is_recurrence_exception = false
 `
 
 func (q *Queries) DeleteFutureTasksByRecurringTasksTemplateID(ctx context.Context, recurringTemplateID pgtype.Int4) error {
@@ -90,7 +123,7 @@ func (q *Queries) DeleteFutureTasksByRecurringTasksTemplateID(ctx context.Contex
 	return err
 }
 
-const deleteTaskByID = `-- name: DeleteTaskByID :exec
+const deleteTaskByID = `-- name: DeleteTaskByID :execrows
 DELETE FROM tasks
 WHERE id = $1 AND user_id = $2
 `
@@ -100,14 +133,36 @@ type DeleteTaskByIDParams struct {
 	UserID int32 `json:"user_id"`
 }
 
-func (q *Queries) DeleteTaskByID(ctx context.Context, arg DeleteTaskByIDParams) error {
-	_, err := q.db.Exec(ctx, deleteTaskByID, arg.ID, arg.UserID)
+func (q *Queries) DeleteTaskByID(ctx context.Context, arg DeleteTaskByIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTaskByID, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTasksFromDateByRecurringTasksTemplateID = `-- name: DeleteTasksFromDateByRecurringTasksTemplateID :exec
+DELETE FROM tasks
+WHERE recurring_template_id = $1
+  AND user_id = $2
+  AND scheduled_date >= $3
+  AND is_done = false
+`
+
+type DeleteTasksFromDateByRecurringTasksTemplateIDParams struct {
+	RecurringTemplateID pgtype.Int4 `json:"recurring_template_id"`
+	UserID              int32       `json:"user_id"`
+	ScheduledDate       pgtype.Date `json:"scheduled_date"`
+}
+
+func (q *Queries) DeleteTasksFromDateByRecurringTasksTemplateID(ctx context.Context, arg DeleteTasksFromDateByRecurringTasksTemplateIDParams) error {
+	_, err := q.db.Exec(ctx, deleteTasksFromDateByRecurringTasksTemplateID, arg.RecurringTemplateID, arg.UserID, arg.ScheduledDate)
 	return err
 }
 
 const getTaskByID = `-- name: GetTaskByID :one
-SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at FROM tasks
-WHERE id = $1 AND user_id = $2 LIMIT 1
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
+WHERE id = $1 AND (user_id = $2 OR goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = $2)) LIMIT 1
 `
 
 type GetTaskByIDParams struct {
@@ -131,14 +186,91 @@ func (q *Queries) GetTaskByID(ctx context.Context, arg GetTaskByIDParams) (Task,
 		&i.DurationMinutes,
 		&i.RescheduleCount,
 		&i.CreatedAt,
+		&i.Priority,
+		&i.IsRecurrenceException,
+		&i.CompletedAt,
+		&i.AssigneeID,
+		&i.Description,
 	)
 	return i, err
 }
 
+const listAssignedTasks = `-- name: ListAssignedTasks :many
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
+WHERE assignee_id = $1::int
+  AND is_done = false
+  AND (user_id = $1::int OR goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = $1::int))
+ORDER BY scheduled_date ASC NULLS LAST, priority DESC, id
+`
+
+func (q *Queries) ListAssignedTasks(ctx context.Context, userID int32) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listAssignedTasks, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GoalID,
+			&i.RecurringTemplateID,
+			&i.Title,
+			&i.IsDone,
+			&i.ScheduledDate,
+			&i.HasTime,
+			&i.ScheduledTime,
+			&i.DurationMinutes,
+			&i.RescheduleCount,
+			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCompletedAtByGoalID = `-- name: ListCompletedAtByGoalID :many
+SELECT completed_at FROM tasks
+WHERE goal_id = $1 AND completed_at IS NOT NULL
+ORDER BY completed_at
+`
+
+func (q *Queries) ListCompletedAtByGoalID(ctx context.Context, goalID int32) ([]pgtype.Timestamp, error) {
+	rows, err := q.db.Query(ctx, listCompletedAtByGoalID, goalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.Timestamp
+	for rows.Next() {
+		var completed_at pgtype.Timestamp
+		if err := rows.Scan(&completed_at); err != nil {
+			return nil, err
+		}
+		items = append(items, completed_at)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listInboxTasks = `-- name: ListInboxTasks :many
-SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at FROM tasks
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
 WHERE scheduled_date IS null AND has_time = false AND is_done = false AND user_id = $1
-ORDER BY id DESC
+ORDER BY priority DESC, id DESC
 `
 
 func (q *Queries) ListInboxTasks(ctx context.Context, userID int32) ([]Task, error) {
@@ -163,6 +295,11 @@ func (q *Queries) ListInboxTasks(ctx context.Context, userID int32) ([]Task, err
 			&i.DurationMinutes,
 			&i.RescheduleCount,
 			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -174,14 +311,116 @@ func (q *Queries) ListInboxTasks(ctx context.Context, userID int32) ([]Task, err
 	return items, nil
 }
 
-const listTasks = `-- name: ListTasks :many
-SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at FROM tasks
+const listOccurrencesByRecurringTasksTemplateID = `-- name: ListOccurrencesByRecurringTasksTemplateID :many
+SELECT scheduled_date, is_done, completed_at FROM tasks
+WHERE recurring_template_id = $1 AND scheduled_date <= $2
+ORDER BY scheduled_date
+`
+
+type ListOccurrencesByRecurringTasksTemplateIDParams struct {
+	RecurringTemplateID pgtype.Int4 `json:"recurring_template_id"`
+	ScheduledDate       pgtype.Date `json:"scheduled_date"`
+}
+
+type ListOccurrencesByRecurringTasksTemplateIDRow struct {
+	ScheduledDate pgtype.Date      `json:"scheduled_date"`
+	IsDone        bool             `json:"is_done"`
+	CompletedAt   pgtype.Timestamp `json:"completed_at"`
+}
+
+func (q *Queries) ListOccurrencesByRecurringTasksTemplateID(ctx context.Context, arg ListOccurrencesByRecurringTasksTemplateIDParams) ([]ListOccurrencesByRecurringTasksTemplateIDRow, error) {
+	rows, err := q.db.Query(ctx, listOccurrencesByRecurringTasksTemplateID, arg.RecurringTemplateID, arg.ScheduledDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOccurrencesByRecurringTasksTemplateIDRow
+	for rows.Next() {
+		var i ListOccurrencesByRecurringTasksTemplateIDRow
+		if err := rows.Scan(&i.ScheduledDate, &i.IsDone, &i.CompletedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOverdueTasks = `-- name: ListOverdueTasks :many
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
+WHERE user_id = $1 AND is_done = false AND scheduled_date < $2
+ORDER BY priority DESC, scheduled_date ASC, id
+`
+
+type ListOverdueTasksParams struct {
+	UserID        int32       `json:"user_id"`
+	ScheduledDate pgtype.Date `json:"scheduled_date"`
+}
+
+func (q *Queries) ListOverdueTasks(ctx context.Context, arg ListOverdueTasksParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listOverdueTasks, arg.UserID, arg.ScheduledDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GoalID,
+			&i.RecurringTemplateID,
+			&i.Title,
+			&i.IsDone,
+			&i.ScheduledDate,
+			&i.HasTime,
+			&i.ScheduledTime,
+			&i.DurationMinutes,
+			&i.RescheduleCount,
+			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecurringTasksByDateRange = `-- name: ListRecurringTasksByDateRange :many
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
 WHERE user_id = $1
-ORDER BY id
+  AND recurring_template_id IS NOT NULL
+  AND ($2::int IS NULL OR recurring_template_id = $2::int)
+  AND scheduled_date >= $3::date
+  AND scheduled_date <= $4::date
+ORDER BY scheduled_date, id
 `
 
-func (q *Queries) ListTasks(ctx context.Context, userID int32) ([]Task, error) {
-	rows, err := q.db.Query(ctx, listTasks, userID)
+type ListRecurringTasksByDateRangeParams struct {
+	UserID              int32       `json:"user_id"`
+	RecurringTemplateID pgtype.Int4 `json:"recurring_template_id"`
+	AfterDate           pgtype.Date `json:"after_date"`
+	BeforeDate          pgtype.Date `json:"before_date"`
+}
+
+func (q *Queries) ListRecurringTasksByDateRange(ctx context.Context, arg ListRecurringTasksByDateRangeParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listRecurringTasksByDateRange,
+		arg.UserID,
+		arg.RecurringTemplateID,
+		arg.AfterDate,
+		arg.BeforeDate,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -202,6 +441,11 @@ func (q *Queries) ListTasks(ctx context.Context, userID int32) ([]Task, error) {
 			&i.DurationMinutes,
 			&i.RescheduleCount,
 			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -214,19 +458,26 @@ func (q *Queries) ListTasks(ctx context.Context, userID int32) ([]Task, error) {
 }
 
 const listTasksByDateRange = `-- name: ListTasksByDateRange :many
-SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at FROM tasks
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
 WHERE user_id = $3 AND scheduled_date >= $1 AND scheduled_date <= $2
-ORDER BY scheduled_time ASC, id
+  AND ($4::int IS NULL OR id IN (SELECT task_id FROM task_tags WHERE tag_id = $4::int))
+ORDER BY priority DESC, scheduled_time ASC, id
 `
 
 type ListTasksByDateRangeParams struct {
 	ScheduledDate   pgtype.Date `json:"scheduled_date"`
 	ScheduledDate_2 pgtype.Date `json:"scheduled_date_2"`
 	UserID          int32       `json:"user_id"`
+	TagID           pgtype.Int4 `json:"tag_id"`
 }
 
 func (q *Queries) ListTasksByDateRange(ctx context.Context, arg ListTasksByDateRangeParams) ([]Task, error) {
-	rows, err := q.db.Query(ctx, listTasksByDateRange, arg.ScheduledDate, arg.ScheduledDate_2, arg.UserID)
+	rows, err := q.db.Query(ctx, listTasksByDateRange,
+		arg.ScheduledDate,
+		arg.ScheduledDate_2,
+		arg.UserID,
+		arg.TagID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -247,6 +498,11 @@ func (q *Queries) ListTasksByDateRange(ctx context.Context, arg ListTasksByDateR
 			&i.DurationMinutes,
 			&i.RescheduleCount,
 			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -258,19 +514,211 @@ func (q *Queries) ListTasksByDateRange(ctx context.Context, arg ListTasksByDateR
 	return items, nil
 }
 
-const listTasksByGoalID = `-- name: ListTasksByGoalID :many
-SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at FROM tasks
-WHERE goal_id = $1 AND user_id = $2
-ORDER BY is_done ASC, id DESC
+const listTasksPage = `-- name: ListTasksPage :many
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
+WHERE (
+    user_id = $1
+    OR ($2::int IS NOT NULL AND goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = $1))
+  )
+  AND ($2::int IS NULL OR goal_id = $2::int)
+  AND ($3::int IS NULL OR id IN (SELECT task_id FROM task_tags WHERE tag_id = $3::int))
+  AND ($4::bool IS NULL OR is_done = $4::bool)
+  AND ($5::bool IS NULL OR has_time = $5::bool)
+  AND ($6::int IS NULL OR recurring_template_id = $6::int)
+  AND ($7::date IS NULL OR scheduled_date >= $7::date)
+  AND ($8::date IS NULL OR scheduled_date <= $8::date)
+  AND (
+    $9::bigint IS NULL
+    OR priority < $10::task_priority
+    OR (priority = $10::task_priority AND is_done > $11::bool)
+    OR (priority = $10::task_priority AND is_done = $11::bool AND id < $9::bigint)
+  )
+ORDER BY priority DESC, is_done ASC, id DESC
+LIMIT $12::int
 `
 
-type ListTasksByGoalIDParams struct {
-	GoalID int32 `json:"goal_id"`
-	UserID int32 `json:"user_id"`
+type ListTasksPageParams struct {
+	UserID              int32            `json:"user_id"`
+	GoalID              pgtype.Int4      `json:"goal_id"`
+	TagID               pgtype.Int4      `json:"tag_id"`
+	IsDone              pgtype.Bool      `json:"is_done"`
+	HasTime             pgtype.Bool      `json:"has_time"`
+	RecurringTemplateID pgtype.Int4      `json:"recurring_template_id"`
+	ScheduledAfter      pgtype.Date      `json:"scheduled_after"`
+	ScheduledBefore     pgtype.Date      `json:"scheduled_before"`
+	CursorID            pgtype.Int8      `json:"cursor_id"`
+	CursorPriority      NullTaskPriority `json:"cursor_priority"`
+	CursorIsDone        pgtype.Bool      `json:"cursor_is_done"`
+	RowLimit            pgtype.Int4      `json:"row_limit"`
 }
 
-func (q *Queries) ListTasksByGoalID(ctx context.Context, arg ListTasksByGoalIDParams) ([]Task, error) {
-	rows, err := q.db.Query(ctx, listTasksByGoalID, arg.GoalID, arg.UserID)
+func (q *Queries) ListTasksPage(ctx context.Context, arg ListTasksPageParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listTasksPage,
+		arg.UserID,
+		arg.GoalID,
+		arg.TagID,
+		arg.IsDone,
+		arg.HasTime,
+		arg.RecurringTemplateID,
+		arg.ScheduledAfter,
+		arg.ScheduledBefore,
+		arg.CursorID,
+		arg.CursorPriority,
+		arg.CursorIsDone,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GoalID,
+			&i.RecurringTemplateID,
+			&i.Title,
+			&i.IsDone,
+			&i.ScheduledDate,
+			&i.HasTime,
+			&i.ScheduledTime,
+			&i.DurationMinutes,
+			&i.RescheduleCount,
+			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUpcomingTasksByRecurringTemplateID = `-- name: ListUpcomingTasksByRecurringTemplateID :many
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
+WHERE recurring_template_id = $1 AND scheduled_date >= $3
+  AND (user_id = $2 OR goal_id IN (SELECT goal_id FROM goal_members WHERE goal_members.user_id = $2))
+  AND is_done = false AND has_time = true
+ORDER BY scheduled_date, id
+`
+
+type ListUpcomingTasksByRecurringTemplateIDParams struct {
+	RecurringTemplateID pgtype.Int4 `json:"recurring_template_id"`
+	UserID              int32       `json:"user_id"`
+	ScheduledDate       pgtype.Date `json:"scheduled_date"`
+}
+
+func (q *Queries) ListUpcomingTasksByRecurringTemplateID(ctx context.Context, arg ListUpcomingTasksByRecurringTemplateIDParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, listUpcomingTasksByRecurringTemplateID, arg.RecurringTemplateID, arg.UserID, arg.ScheduledDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GoalID,
+			&i.RecurringTemplateID,
+			&i.Title,
+			&i.IsDone,
+			&i.ScheduledDate,
+			&i.HasTime,
+			&i.ScheduledTime,
+			&i.DurationMinutes,
+			&i.RescheduleCount,
+			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rollOverOverdueTasks = `-- name: RollOverOverdueTasks :execrows
+WITH overdue AS (
+    SELECT tasks.id, tasks.scheduled_date, tasks.reschedule_count, (now() AT TIME ZONE users.time_zone)::date AS today
+    FROM tasks
+    JOIN users ON users.id = tasks.user_id
+    WHERE users.auto_rollover = true
+      AND tasks.is_done = false
+      AND tasks.recurring_template_id IS NULL
+      AND tasks.scheduled_date < (now() AT TIME ZONE users.time_zone)::date
+    FOR UPDATE OF tasks
+), activity AS (
+    INSERT INTO task_activity (task_id, action, changes)
+    SELECT id, 'rescheduled', jsonb_build_object(
+        'scheduled_date', jsonb_build_object('old', scheduled_date, 'new', today),
+        'reschedule_count', jsonb_build_object('old', reschedule_count, 'new', reschedule_count + 1)
+    )
+    FROM overdue
+)
+UPDATE tasks
+SET
+    scheduled_date = overdue.today,
+    reschedule_count = tasks.reschedule_count + 1
+FROM overdue
+WHERE tasks.id = overdue.id
+`
+
+func (q *Queries) RollOverOverdueTasks(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, rollOverOverdueTasks)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const searchTasks = `-- name: SearchTasks :many
+SELECT id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description FROM tasks
+WHERE user_id = $1
+  AND to_tsvector('simple', title || ' ' || description) @@ websearch_to_tsquery('simple', $2::text)
+  AND ($3::int IS NULL OR goal_id = $3::int)
+  AND ($4::bool IS NULL OR is_done = $4::bool)
+  AND ($5::date IS NULL OR scheduled_date >= $5::date)
+  AND ($6::date IS NULL OR scheduled_date <= $6::date)
+ORDER BY ts_rank(to_tsvector('simple', title || ' ' || description), websearch_to_tsquery('simple', $2::text)) DESC, priority DESC, id DESC
+LIMIT 100
+`
+
+type SearchTasksParams struct {
+	UserID     int32       `json:"user_id"`
+	Query      string      `json:"query"`
+	GoalID     pgtype.Int4 `json:"goal_id"`
+	IsDone     pgtype.Bool `json:"is_done"`
+	AfterDate  pgtype.Date `json:"after_date"`
+	BeforeDate pgtype.Date `json:"before_date"`
+}
+
+func (q *Queries) SearchTasks(ctx context.Context, arg SearchTasksParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, searchTasks,
+		arg.UserID,
+		arg.Query,
+		arg.GoalID,
+		arg.IsDone,
+		arg.AfterDate,
+		arg.BeforeDate,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -291,6 +739,11 @@ func (q *Queries) ListTasksByGoalID(ctx context.Context, arg ListTasksByGoalIDPa
 			&i.DurationMinutes,
 			&i.RescheduleCount,
 			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -302,11 +755,27 @@ func (q *Queries) ListTasksByGoalID(ctx context.Context, arg ListTasksByGoalIDPa
 	return items, nil
 }
 
+const unassignTasksByGoalID = `-- name: UnassignTasksByGoalID :exec
+UPDATE tasks
+SET assignee_id = NULL
+WHERE goal_id = $1 AND assignee_id = $2::int
+`
+
+type UnassignTasksByGoalIDParams struct {
+	GoalID     int32 `json:"goal_id"`
+	AssigneeID int32 `json:"assignee_id"`
+}
+
+func (q *Queries) UnassignTasksByGoalID(ctx context.Context, arg UnassignTasksByGoalIDParams) error {
+	_, err := q.db.Exec(ctx, unassignTasksByGoalID, arg.GoalID, arg.AssigneeID)
+	return err
+}
+
 const updateIsDoneInTaskByID = `-- name: UpdateIsDoneInTaskByID :one
 UPDATE tasks
-SET is_done = $3
+SET is_done = $3, completed_at = CASE WHEN NOT $3 THEN NULL WHEN is_done THEN completed_at ELSE now() END
 WHERE id = $1 AND user_id = $2
-RETURNING id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at
+RETURNING id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description
 `
 
 type UpdateIsDoneInTaskByIDParams struct {
@@ -331,6 +800,11 @@ func (q *Queries) UpdateIsDoneInTaskByID(ctx context.Context, arg UpdateIsDoneIn
 		&i.DurationMinutes,
 		&i.RescheduleCount,
 		&i.CreatedAt,
+		&i.Priority,
+		&i.IsRecurrenceException,
+		&i.CompletedAt,
+		&i.AssigneeID,
+		&i.Description,
 	)
 	return i, err
 }
@@ -346,23 +820,32 @@ SET
     has_time = $8,
     scheduled_time = $9,
     duration_minutes = $10,
-    reschedule_count = $11
+    reschedule_count = $11,
+    priority = $12,
+    is_recurrence_exception = $13,
+    assignee_id = $14,
+    description = $15,
+    completed_at = CASE WHEN NOT $6 THEN NULL WHEN is_done THEN completed_at ELSE now() END
 WHERE id = $1 AND user_id = $2
-RETURNING id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at
+RETURNING id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description
 `
 
 type UpdateTaskByIDParams struct {
-	ID                  int64       `json:"id"`
-	UserID              int32       `json:"user_id"`
-	GoalID              int32       `json:"goal_id"`
-	RecurringTemplateID pgtype.Int4 `json:"recurring_template_id"`
-	Title               string      `json:"title"`
-	IsDone              bool        `json:"is_done"`
-	ScheduledDate       pgtype.Date `json:"scheduled_date"`
-	HasTime             bool        `json:"has_time"`
-	ScheduledTime       pgtype.Time `json:"scheduled_time"`
-	DurationMinutes     pgtype.Int4 `json:"duration_minutes"`
-	RescheduleCount     int32       `json:"reschedule_count"`
+	ID                    int64        `json:"id"`
+	UserID                int32        `json:"user_id"`
+	GoalID                int32        `json:"goal_id"`
+	RecurringTemplateID   pgtype.Int4  `json:"recurring_template_id"`
+	Title                 string       `json:"title"`
+	IsDone                bool         `json:"is_done"`
+	ScheduledDate         pgtype.Date  `json:"scheduled_date"`
+	HasTime               bool         `json:"has_time"`
+	ScheduledTime         pgtype.Time  `json:"scheduled_time"`
+	DurationMinutes       pgtype.Int4  `json:"duration_minutes"`
+	RescheduleCount       int32        `json:"reschedule_count"`
+	Priority              TaskPriority `json:"priority"`
+	IsRecurrenceException bool         `json:"is_recurrence_exception"`
+	AssigneeID            pgtype.Int4  `json:"assignee_id"`
+	Description           string       `json:"description"`
 }
 
 func (q *Queries) UpdateTaskByID(ctx context.Context, arg UpdateTaskByIDParams) (Task, error) {
@@ -378,6 +861,10 @@ func (q *Queries) UpdateTaskByID(ctx context.Context, arg UpdateTaskByIDParams)
 		arg.ScheduledTime,
 		arg.DurationMinutes,
 		arg.RescheduleCount,
+		arg.Priority,
+		arg.IsRecurrenceException,
+		arg.AssigneeID,
+		arg.Description,
 	)
 	var i Task
 	err := row.Scan(
@@ -393,6 +880,88 @@ func (q *Queries) UpdateTaskByID(ctx context.Context, arg UpdateTaskByIDParams)
 		&i.DurationMinutes,
 		&i.RescheduleCount,
 		&i.CreatedAt,
+		&i.Priority,
+		&i.IsRecurrenceException,
+		&i.CompletedAt,
+		&i.AssigneeID,
+		&i.Description,
 	)
 	return i, err
 }
+
+const updateUpcomingTasksByRecurringTasksTemplateID = `-- name: UpdateUpcomingTasksByRecurringTasksTemplateID :many
+UPDATE tasks
+SET
+    goal_id = $1,
+    title = $2,
+    has_time = $3,
+    scheduled_time = $4,
+    duration_minutes = $5,
+    priority = $6
+WHERE recurring_template_id = $7
+  AND user_id = $8
+  AND scheduled_date >= $9::date
+  AND is_done = false
+  AND is_recurrence_exception = false
+RETURNING id, user_id, goal_id, recurring_template_id, title, is_done, scheduled_date, has_time, scheduled_time, duration_minutes, reschedule_count, created_at, priority, is_recurrence_exception, completed_at, assignee_id, description
+`
+
+type UpdateUpcomingTasksByRecurringTasksTemplateIDParams struct {
+	GoalID              int32        `json:"goal_id"`
+	Title               string       `json:"title"`
+	HasTime             bool         `json:"has_time"`
+	ScheduledTime       pgtype.Time  `json:"scheduled_time"`
+	DurationMinutes     pgtype.Int4  `json:"duration_minutes"`
+	Priority            TaskPriority `json:"priority"`
+	RecurringTemplateID pgtype.Int4  `json:"recurring_template_id"`
+	UserID              int32        `json:"user_id"`
+	ScheduledAfter      pgtype.Date  `json:"scheduled_after"`
+}
+
+func (q *Queries) UpdateUpcomingTasksByRecurringTasksTemplateID(ctx context.Context, arg UpdateUpcomingTasksByRecurringTasksTemplateIDParams) ([]Task, error) {
+	rows, err := q.db.Query(ctx, updateUpcomingTasksByRecurringTasksTemplateID,
+		arg.GoalID,
+		arg.Title,
+		arg.HasTime,
+		arg.ScheduledTime,
+		arg.DurationMinutes,
+		arg.Priority,
+		arg.RecurringTemplateID,
+		arg.UserID,
+		arg.ScheduledAfter,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.GoalID,
+			&i.RecurringTemplateID,
+			&i.Title,
+			&i.IsDone,
+			&i.ScheduledDate,
+			&i.HasTime,
+			&i.ScheduledTime,
+			&i.DurationMinutes,
+			&i.RescheduleCount,
+			&i.CreatedAt,
+			&i.Priority,
+			&i.IsRecurrenceException,
+			&i.CompletedAt,
+			&i.AssigneeID,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -7,6 +7,8 @@ package repo
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createUser = `-- name: CreateUser :one
@@ -15,7 +17,7 @@ INSERT INTO users (
 ) VALUES (
     $1, $2
 )
-RETURNING id, email, password_hash, created_at
+RETURNING id, email, password_hash, created_at, time_zone, auto_rollover, calendar_token_hash, email_notifications, email_agenda
 `
 
 type CreateUserParams struct {
@@ -31,12 +33,39 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Email,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.TimeZone,
+		&i.AutoRollover,
+		&i.CalendarTokenHash,
+		&i.EmailNotifications,
+		&i.EmailAgenda,
+	)
+	return i, err
+}
+
+const getUserByCalendarTokenHash = `-- name: GetUserByCalendarTokenHash :one
+SELECT id, email, password_hash, created_at, time_zone, auto_rollover, calendar_token_hash, email_notifications, email_agenda FROM users
+WHERE calendar_token_hash = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserByCalendarTokenHash(ctx context.Context, calendarTokenHash pgtype.Text) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByCalendarTokenHash, calendarTokenHash)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.TimeZone,
+		&i.AutoRollover,
+		&i.CalendarTokenHash,
+		&i.EmailNotifications,
+		&i.EmailAgenda,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, created_at FROM users
+SELECT id, email, password_hash, created_at, time_zone, auto_rollover, calendar_token_hash, email_notifications, email_agenda FROM users
 WHERE email = $1 LIMIT 1
 `
 
@@ -48,12 +77,17 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Email,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.TimeZone,
+		&i.AutoRollover,
+		&i.CalendarTokenHash,
+		&i.EmailNotifications,
+		&i.EmailAgenda,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash, created_at FROM users
+SELECT id, email, password_hash, created_at, time_zone, auto_rollover, calendar_token_hash, email_notifications, email_agenda FROM users
 WHERE id = $1 LIMIT 1
 `
 
@@ -65,6 +99,140 @@ func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 		&i.Email,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.TimeZone,
+		&i.AutoRollover,
+		&i.CalendarTokenHash,
+		&i.EmailNotifications,
+		&i.EmailAgenda,
+	)
+	return i, err
+}
+
+const listAgendaRecipients = `-- name: ListAgendaRecipients :many
+SELECT id, email, time_zone FROM users
+WHERE email_agenda = true
+ORDER BY id
+`
+
+type ListAgendaRecipientsRow struct {
+	ID       int64  `json:"id"`
+	Email    string `json:"email"`
+	TimeZone string `json:"time_zone"`
+}
+
+func (q *Queries) ListAgendaRecipients(ctx context.Context) ([]ListAgendaRecipientsRow, error) {
+	rows, err := q.db.Query(ctx, listAgendaRecipients)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAgendaRecipientsRow
+	for rows.Next() {
+		var i ListAgendaRecipientsRow
+		if err := rows.Scan(&i.ID, &i.Email, &i.TimeZone); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserTimeZones = `-- name: ListUserTimeZones :many
+SELECT id, time_zone FROM users
+ORDER BY id
+`
+
+type ListUserTimeZonesRow struct {
+	ID       int64  `json:"id"`
+	TimeZone string `json:"time_zone"`
+}
+
+func (q *Queries) ListUserTimeZones(ctx context.Context) ([]ListUserTimeZonesRow, error) {
+	rows, err := q.db.Query(ctx, listUserTimeZones)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserTimeZonesRow
+	for rows.Next() {
+		var i ListUserTimeZonesRow
+		if err := rows.Scan(&i.ID, &i.TimeZone); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCalendarTokenHashInUserByID = `-- name: UpdateCalendarTokenHashInUserByID :one
+UPDATE users
+SET calendar_token_hash = $2
+WHERE id = $1
+RETURNING id, email, password_hash, created_at, time_zone, auto_rollover, calendar_token_hash, email_notifications, email_agenda
+`
+
+type UpdateCalendarTokenHashInUserByIDParams struct {
+	ID                int64       `json:"id"`
+	CalendarTokenHash pgtype.Text `json:"calendar_token_hash"`
+}
+
+func (q *Queries) UpdateCalendarTokenHashInUserByID(ctx context.Context, arg UpdateCalendarTokenHashInUserByIDParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateCalendarTokenHashInUserByID, arg.ID, arg.CalendarTokenHash)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.TimeZone,
+		&i.AutoRollover,
+		&i.CalendarTokenHash,
+		&i.EmailNotifications,
+		&i.EmailAgenda,
+	)
+	return i, err
+}
+
+const updateSettingsInUserByID = `-- name: UpdateSettingsInUserByID :one
+UPDATE users
+SET time_zone = $2, auto_rollover = $3, email_notifications = $4, email_agenda = $5
+WHERE id = $1
+RETURNING id, email, password_hash, created_at, time_zone, auto_rollover, calendar_token_hash, email_notifications, email_agenda
+`
+
+type UpdateSettingsInUserByIDParams struct {
+	ID                 int64  `json:"id"`
+	TimeZone           string `json:"time_zone"`
+	AutoRollover       bool   `json:"auto_rollover"`
+	EmailNotifications bool   `json:"email_notifications"`
+	EmailAgenda        bool   `json:"email_agenda"`
+}
+
+func (q *Queries) UpdateSettingsInUserByID(ctx context.Context, arg UpdateSettingsInUserByIDParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateSettingsInUserByID,
+		arg.ID,
+		arg.TimeZone,
+		arg.AutoRollover,
+		arg.EmailNotifications,
+		arg.EmailAgenda,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.TimeZone,
+		&i.AutoRollover,
+		&i.CalendarTokenHash,
+		&i.EmailNotifications,
+		&i.EmailAgenda,
 	)
 	return i, err
 }
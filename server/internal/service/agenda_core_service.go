@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (s *agendaService) sendDailyAgendaInternal(ctx context.Context, recipient repo.ListAgendaRecipientsRow, today time.Time) (bool, error) {
+	date := pgtype.Date{
+		Time:  today,
+		Valid: true,
+	}
+
+	tasks, err := s.repo.ListTasksByDateRange(ctx, repo.ListTasksByDateRangeParams{
+		ScheduledDate:   date,
+		ScheduledDate_2: date,
+		UserID:          int32(recipient.ID),
+	})
+	if err != nil {
+		return false, fmt.Errorf("couldn't get tasks of today: %w", err)
+	}
+
+	data := domain.AgendaEmailData{
+		Date: today.Format("Monday, January 2"),
+	}
+	for _, task := range domain.ToTaskOutputList(tasks) {
+		if task.IsDone {
+			continue
+		}
+
+		data.Tasks = append(data.Tasks, toAgendaTaskData(task))
+	}
+
+	if len(data.Tasks) == 0 {
+		return false, nil
+	}
+
+	err = s.mailer.Send(ctx, domain.EmailMessage{
+		To:       recipient.Email,
+		Subject:  fmt.Sprintf("Your agenda for %s", data.Date),
+		Template: domain.EmailTemplateAgenda,
+		Data:     data,
+	})
+	if err != nil {
+		return false, fmt.Errorf("couldn't send agenda email: %w", err)
+	}
+
+	return true, nil
+}
+
+func toAgendaTaskData(task domain.TaskOutput) domain.AgendaTaskData {
+	output := domain.AgendaTaskData{
+		Title:           task.Title,
+		DurationMinutes: task.DurationMinutes,
+		Priority:        task.Priority,
+	}
+
+	if task.HasTime {
+		output.Time = task.ScheduledTime.Format("15:04")
+	}
+
+	return output
+}
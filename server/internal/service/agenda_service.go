@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+// agendaHour is the local hour of user at which daily agenda is sent.
+const agendaHour = 7
+
+type agendaService struct {
+	repo   repo.Querier
+	mailer domain.Mailer
+}
+
+// NewAgendaService creates service sending daily agenda emails, without mailer no email is sent.
+func NewAgendaService(repo repo.Querier, mailer domain.Mailer) domain.AgendaService {
+	return &agendaService{
+		repo:   repo,
+		mailer: mailer,
+	}
+}
+
+// SendDailyAgendas emails open tasks of today to every user with agenda turned on whose local time is agendaHour.
+// It runs hourly, so every time zone gets its agenda in the morning. Users with nothing planned get no email.
+func (s *agendaService) SendDailyAgendas(ctx context.Context) (int64, error) {
+	if s.mailer == nil {
+		return 0, nil
+	}
+
+	recipients, err := s.repo.ListAgendaRecipients(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get agenda recipients: %w", err)
+	}
+
+	now := time.Now()
+
+	var sent int64
+	for _, recipient := range recipients {
+		loc, err := time.LoadLocation(recipient.TimeZone)
+		if err != nil {
+			loc = time.UTC
+		}
+
+		if now.In(loc).Hour() != agendaHour {
+			continue
+		}
+
+		ok, err := s.sendDailyAgendaInternal(ctx, recipient, dateInLocation(now, loc))
+		if err != nil {
+			slog.Error("failed to send daily agenda", "user_id", recipient.ID, "error", err)
+			continue
+		}
+
+		if ok {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
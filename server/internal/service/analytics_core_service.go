@@ -0,0 +1,87 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+// taskStatistics sums counters of goals, rates are only derived once everything is added up.
+type taskStatistics struct {
+	totalTasks           int32
+	completedTasks       int32
+	plannedMinutes       int32
+	completedMinutes     int32
+	totalRescheduleCount int32
+}
+
+func (s *taskStatistics) add(row repo.ListGoalStatisticsByDateRangeRow) {
+	s.totalTasks += row.TotalTasks
+	s.completedTasks += row.CompletedTasks
+	s.plannedMinutes += row.PlannedMinutes
+	s.completedMinutes += row.CompletedMinutes
+	s.totalRescheduleCount += row.TotalRescheduleCount
+}
+
+func (s *taskStatistics) toOutput() domain.TaskStatisticsOutput {
+	output := domain.TaskStatisticsOutput{
+		TotalTasks:       s.totalTasks,
+		CompletedTasks:   s.completedTasks,
+		PlannedMinutes:   s.plannedMinutes,
+		CompletedMinutes: s.completedMinutes,
+	}
+
+	if s.totalTasks > 0 {
+		output.CompletionRate = float64(s.completedTasks) / float64(s.totalTasks)
+		output.AverageRescheduleCount = float64(s.totalRescheduleCount) / float64(s.totalTasks)
+	}
+
+	return output
+}
+
+// toAnalyticsOutput lists every goal, even without tasks in range, and every category in a fixed order.
+func toAnalyticsOutput(rows []repo.ListGoalStatisticsByDateRangeRow, afterDate time.Time, beforeDate time.Time) *domain.AnalyticsOutput {
+	categoryTypes := []repo.GoalsCategoryType{
+		repo.GoalsCategoryTypeGrowth,
+		repo.GoalsCategoryTypeMaintenance,
+		repo.GoalsCategoryTypeOther,
+	}
+
+	var total taskStatistics
+	categories := make(map[repo.GoalsCategoryType]*taskStatistics, len(categoryTypes))
+	for _, categoryType := range categoryTypes {
+		categories[categoryType] = &taskStatistics{}
+	}
+
+	output := &domain.AnalyticsOutput{
+		AfterDate:  afterDate,
+		BeforeDate: beforeDate,
+		Goals:      make([]domain.GoalStatisticsOutput, len(rows)),
+		Categories: make([]domain.CategoryStatisticsOutput, len(categoryTypes)),
+	}
+
+	for i, row := range rows {
+		var goal taskStatistics
+		goal.add(row)
+		total.add(row)
+		categories[row.CategoryType].add(row)
+
+		output.Goals[i] = domain.GoalStatisticsOutput{
+			GoalID:       row.GoalID,
+			Title:        row.Title,
+			CategoryType: string(row.CategoryType),
+			Statistics:   goal.toOutput(),
+		}
+	}
+
+	for i, categoryType := range categoryTypes {
+		output.Categories[i] = domain.CategoryStatisticsOutput{
+			CategoryType: string(categoryType),
+			Statistics:   categories[categoryType].toOutput(),
+		}
+	}
+	output.Total = total.toOutput()
+
+	return output
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// defaultAnalyticsDays is the length of the range ending today used when no dates are given.
+const defaultAnalyticsDays = 30
+
+type analyticsService struct {
+	repo        repo.Querier
+	userService domain.UserService
+}
+
+func NewAnalyticsService(repo repo.Querier, userService domain.UserService) domain.AnalyticsService {
+	return &analyticsService{
+		repo:        repo,
+		userService: userService,
+	}
+}
+
+// GetAnalytics breaks down scheduled tasks of range by goal and by goal category, inbox tasks have no date and are left out.
+func (s *analyticsService) GetAnalytics(ctx context.Context, input domain.GetAnalyticsInput) (*domain.AnalyticsOutput, error) {
+	if input.AfterDate.IsZero() || input.BeforeDate.IsZero() {
+		loc, err := s.userService.GetUserLocation(ctx, nil, int64(input.UserID))
+		if err != nil {
+			return nil, err
+		}
+
+		if input.BeforeDate.IsZero() {
+			input.BeforeDate = dateInLocation(time.Now(), loc)
+		}
+		if input.AfterDate.IsZero() {
+			input.AfterDate = input.BeforeDate.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+		}
+	}
+
+	rows, err := s.repo.ListGoalStatisticsByDateRange(ctx, repo.ListGoalStatisticsByDateRangeParams{
+		AfterDate: pgtype.Date{
+			Time:  input.AfterDate,
+			Valid: true,
+		},
+		BeforeDate: pgtype.Date{
+			Time:  input.BeforeDate,
+			Valid: true,
+		},
+		UserID: input.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get goal statistics by date range: %w", err)
+	}
+
+	return toAnalyticsOutput(rows, input.AfterDate, input.BeforeDate), nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/ali-nur31/mile-do/pkg/ical"
+)
+
+func toCalendarTaskEvent(task domain.TaskOutput, loc *time.Location) ical.Event {
+	start := time.Date(task.ScheduledDate.Year(), task.ScheduledDate.Month(), task.ScheduledDate.Day(),
+		task.ScheduledTime.Hour(), task.ScheduledTime.Minute(), task.ScheduledTime.Second(), 0, loc)
+
+	return ical.Event{
+		UID:      fmt.Sprintf("task-%d@mile-do", task.ID),
+		Summary:  task.Title,
+		Start:    start,
+		End:      start.Add(time.Duration(task.DurationMinutes) * time.Minute),
+		Priority: toCalendarPriority(task.Priority),
+		Created:  task.CreatedAt,
+	}
+}
+
+func toCalendarTemplateEvent(template domain.RecurringTasksTemplateOutput, loc *time.Location) ical.Event {
+	event := ical.Event{
+		UID:      fmt.Sprintf("recurring-tasks-template-%d@mile-do", template.ID),
+		Summary:  template.Title,
+		RRules:   rruleLines(template.RecurrenceRrule),
+		Priority: toCalendarPriority(template.Priority),
+		Created:  template.CreatedAt,
+	}
+
+	if !template.HasTime {
+		event.AllDay = true
+		event.Start = template.ScheduledDatetime
+		event.ExDates = template.Exdates
+		event.RDates = template.Rdates
+		return event
+	}
+
+	// the template keeps wall clock time of the user, so the series is anchored in user's time zone to follow DST
+	start := template.ScheduledDatetime
+	event.TimeZone = loc.String()
+	event.Start = start
+	event.End = start.Add(time.Duration(template.DurationMinutes) * time.Minute)
+	for _, exdate := range template.Exdates {
+		event.ExDates = append(event.ExDates, occurrenceOnDate(exdate, start))
+	}
+	for _, rdate := range template.Rdates {
+		event.RDates = append(event.RDates, occurrenceOnDate(rdate, start))
+	}
+
+	return event
+}
+
+// rruleLines returns the rules of an rrule set string without their "RRULE:" prefix.
+func rruleLines(rule string) []string {
+	var rules []string
+	for _, line := range strings.Split(rule, "\n") {
+		line = strings.TrimSpace(line)
+		if value, found := strings.CutPrefix(line, "RRULE:"); found {
+			rules = append(rules, value)
+		}
+	}
+	return rules
+}
+
+func toCalendarPriority(priority string) int {
+	switch repo.TaskPriority(priority) {
+	case repo.TaskPriorityHigh:
+		return 1
+	case repo.TaskPriorityMedium:
+		return 5
+	case repo.TaskPriorityLow:
+		return 9
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/ali-nur31/mile-do/pkg/ical"
+)
+
+const (
+	calendarFeedPastMonths   = 1
+	calendarFeedFutureMonths = 3
+)
+
+type calendarService struct {
+	userService                   domain.UserService
+	taskService                   domain.TaskService
+	recurringTasksTemplateService domain.RecurringTasksTemplateService
+}
+
+func NewCalendarService(userService domain.UserService, taskService domain.TaskService, recurringTasksTemplateService domain.RecurringTasksTemplateService) domain.CalendarService {
+	return &calendarService{
+		userService:                   userService,
+		taskService:                   taskService,
+		recurringTasksTemplateService: recurringTasksTemplateService,
+	}
+}
+
+// GetCalendarFeed renders scheduled tasks of the token's owner as iCalendar.
+// Calendar-based recurring templates are exported once with their rrule instead of as generated tasks.
+func (s *calendarService) GetCalendarFeed(ctx context.Context, token string) ([]byte, error) {
+	user, err := s.userService.GetUserByCalendarToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, nil, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.recurringTasksTemplateService.ListRecurringTasksTemplates(ctx, int32(user.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	today := dateInLocation(time.Now(), loc)
+	tasks, err := s.taskService.ListTasksByPeriod(ctx, domain.GetTasksByPeriodInput{
+		UserID:     int32(user.ID),
+		AfterDate:  today.AddDate(0, -calendarFeedPastMonths, 0),
+		BeforeDate: today.AddDate(0, calendarFeedFutureMonths, 0),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := ical.Calendar{
+		ProdID:   "-//Mile-Do//Tasks//EN",
+		Name:     "Mile-Do",
+		TimeZone: loc.String(),
+	}
+
+	exportedTemplates := make(map[int32]bool, len(templates))
+	for _, template := range templates {
+		if template.RecurrenceMode == string(repo.RecurrenceModeAfterCompletion) {
+			continue
+		}
+
+		calendar.Events = append(calendar.Events, toCalendarTemplateEvent(template, loc))
+		exportedTemplates[int32(template.ID)] = true
+	}
+
+	for _, task := range tasks {
+		// occurrences are already covered by the template's rrule, detached ones are excluded from it by exdates
+		if exportedTemplates[task.RecurringTemplateID] && !task.IsRecurrenceException {
+			continue
+		}
+
+		if task.HasTime {
+			calendar.Events = append(calendar.Events, toCalendarTaskEvent(task, loc))
+		} else {
+			calendar.Todos = append(calendar.Todos, ical.Todo{
+				UID:       fmt.Sprintf("task-%d@mile-do", task.ID),
+				Summary:   task.Title,
+				Due:       task.ScheduledDate,
+				AllDay:    true,
+				Completed: task.IsDone,
+				Priority:  toCalendarPriority(task.Priority),
+				Created:   task.CreatedAt,
+			})
+		}
+	}
+
+	return calendar.Encode(), nil
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type exportSettings struct {
+	Email        string `json:"email"`
+	TimeZone     string `json:"time_zone"`
+	AutoRollover bool   `json:"auto_rollover"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type exportGoal struct {
+	ID           int64  `json:"id"`
+	Title        string `json:"title"`
+	Color        string `json:"color"`
+	CategoryType string `json:"category_type"`
+	IsArchived   bool   `json:"is_archived"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type exportTask struct {
+	ID                  int64    `json:"id"`
+	GoalID              int32    `json:"goal_id"`
+	RecurringTemplateID int32    `json:"recurring_template_id,omitempty"`
+	Title               string   `json:"title"`
+	IsDone              bool     `json:"is_done"`
+	ScheduledDate       string   `json:"scheduled_date,omitempty"`
+	ScheduledTime       string   `json:"scheduled_time,omitempty"`
+	DurationMinutes     int32    `json:"duration_minutes"`
+	RescheduleCount     int32    `json:"reschedule_count"`
+	Priority            string   `json:"priority"`
+	Tags                []string `json:"tags"`
+	CompletedAt         string   `json:"completed_at,omitempty"`
+	CreatedAt           string   `json:"created_at"`
+}
+
+type exportRecurringTasksTemplate struct {
+	ID                     int64    `json:"id"`
+	GoalID                 int32    `json:"goal_id"`
+	Title                  string   `json:"title"`
+	ScheduledDatetime      string   `json:"scheduled_datetime"`
+	HasTime                bool     `json:"has_time"`
+	DurationMinutes        int32    `json:"duration_minutes"`
+	RecurrenceRrule        string   `json:"recurrence_rrule"`
+	RecurrenceMode         string   `json:"recurrence_mode"`
+	RecurrenceIntervalDays int32    `json:"recurrence_interval_days"`
+	Priority               string   `json:"priority"`
+	Exdates                []string `json:"exdates"`
+	Rdates                 []string `json:"rdates"`
+	CreatedAt              string   `json:"created_at"`
+}
+
+func (s *exportService) buildExportArchiveInternal(ctx context.Context, userId int32) ([]byte, error) {
+	user, err := s.userService.GetUserByID(ctx, int64(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := s.goalService.ListGoals(ctx, "", userId)
+	if err != nil {
+		return nil, err
+	}
+	// goals shared with user are part of export of their owner
+	goals = slices.DeleteFunc(goals, func(goal domain.GoalOutput) bool {
+		return goal.UserID != userId
+	})
+
+	tasks, err := s.taskService.ListTasks(ctx, domain.ListTasksInput{UserID: userId})
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.recurringTasksTemplateService.ListRecurringTasksTemplates(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := exportSettings{
+		Email:        user.Email,
+		TimeZone:     user.TimeZone,
+		AutoRollover: user.AutoRollover,
+		CreatedAt:    user.CreatedAt.Format(time.RFC3339),
+	}
+
+	outGoals := make([]exportGoal, len(goals))
+	goalRows := [][]string{{"id", "title", "color", "category_type", "is_archived", "created_at"}}
+	for i, goal := range goals {
+		outGoals[i] = exportGoal{
+			ID:           goal.ID,
+			Title:        goal.Title,
+			Color:        goal.Color,
+			CategoryType: goal.CategoryType,
+			IsArchived:   goal.IsArchived,
+			CreatedAt:    goal.CreatedAt.Format(time.RFC3339),
+		}
+		g := outGoals[i]
+		goalRows = append(goalRows, []string{
+			strconv.FormatInt(g.ID, 10), g.Title, g.Color, g.CategoryType, strconv.FormatBool(g.IsArchived), g.CreatedAt,
+		})
+	}
+
+	outTasks := make([]exportTask, len(tasks.Tasks))
+	taskRows := [][]string{{"id", "goal_id", "recurring_template_id", "title", "is_done", "scheduled_date", "scheduled_time", "duration_minutes", "reschedule_count", "priority", "tags", "completed_at", "created_at"}}
+	for i, task := range tasks.Tasks {
+		outTasks[i] = toExportTask(task)
+		t := outTasks[i]
+		taskRows = append(taskRows, []string{
+			strconv.FormatInt(t.ID, 10), formatExportID(t.GoalID), formatExportID(t.RecurringTemplateID), t.Title, strconv.FormatBool(t.IsDone),
+			t.ScheduledDate, t.ScheduledTime, strconv.Itoa(int(t.DurationMinutes)), strconv.Itoa(int(t.RescheduleCount)), t.Priority,
+			strings.Join(t.Tags, ";"), t.CompletedAt, t.CreatedAt,
+		})
+	}
+
+	outTemplates := make([]exportRecurringTasksTemplate, len(templates))
+	templateRows := [][]string{{"id", "goal_id", "title", "scheduled_datetime", "has_time", "duration_minutes", "recurrence_rrule", "recurrence_mode", "recurrence_interval_days", "priority", "exdates", "rdates", "created_at"}}
+	for i, template := range templates {
+		outTemplates[i] = toExportRecurringTasksTemplate(template)
+		t := outTemplates[i]
+		templateRows = append(templateRows, []string{
+			strconv.FormatInt(t.ID, 10), formatExportID(t.GoalID), t.Title, t.ScheduledDatetime, strconv.FormatBool(t.HasTime),
+			strconv.Itoa(int(t.DurationMinutes)), t.RecurrenceRrule, t.RecurrenceMode, strconv.Itoa(int(t.RecurrenceIntervalDays)), t.Priority,
+			strings.Join(t.Exdates, ";"), strings.Join(t.Rdates, ";"), t.CreatedAt,
+		})
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	files := []struct {
+		name    string
+		records any
+		rows    [][]string
+	}{
+		{name: "settings", records: settings},
+		{name: "goals", records: outGoals, rows: goalRows},
+		{name: "tasks", records: outTasks, rows: taskRows},
+		{name: "recurring_tasks_templates", records: outTemplates, rows: templateRows},
+	}
+
+	for _, file := range files {
+		err = writeExportJSON(archive, file.name+".json", file.records)
+		if err != nil {
+			return nil, err
+		}
+
+		if file.rows == nil {
+			continue
+		}
+
+		err = writeExportCSV(archive, file.name+".csv", file.rows)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = archive.Close(); err != nil {
+		return nil, fmt.Errorf("couldn't close export archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *exportService) failExportJobInternal(ctx context.Context, jobId int64, cause error) error {
+	err := s.repo.FinishExportJobByID(ctx, repo.FinishExportJobByIDParams{
+		ID:     jobId,
+		Status: repo.ExportJobStatusFailed,
+		Error: pgtype.Text{
+			String: cause.Error(),
+			Valid:  true,
+		},
+	})
+	if err != nil {
+		return errors.Join(cause, fmt.Errorf("couldn't fail export job: %w", err))
+	}
+
+	return cause
+}
+
+func toExportTask(task domain.TaskOutput) exportTask {
+	output := exportTask{
+		ID:                  task.ID,
+		GoalID:              task.GoalID,
+		RecurringTemplateID: task.RecurringTemplateID,
+		Title:               task.Title,
+		IsDone:              task.IsDone,
+		DurationMinutes:     task.DurationMinutes,
+		RescheduleCount:     task.RescheduleCount,
+		Priority:            task.Priority,
+		Tags:                make([]string, len(task.Tags)),
+		CreatedAt:           task.CreatedAt.Format(time.RFC3339),
+	}
+
+	if !task.ScheduledDate.IsZero() {
+		output.ScheduledDate = task.ScheduledDate.Format(time.DateOnly)
+	}
+	if task.HasTime {
+		output.ScheduledTime = task.ScheduledTime.Format(time.TimeOnly)
+	}
+	if !task.CompletedAt.IsZero() {
+		output.CompletedAt = task.CompletedAt.Format(time.RFC3339)
+	}
+	for i, tag := range task.Tags {
+		output.Tags[i] = tag.Title
+	}
+
+	return output
+}
+
+func toExportRecurringTasksTemplate(template domain.RecurringTasksTemplateOutput) exportRecurringTasksTemplate {
+	output := exportRecurringTasksTemplate{
+		ID:                     template.ID,
+		GoalID:                 template.GoalID,
+		Title:                  template.Title,
+		ScheduledDatetime:      template.ScheduledDatetime.Format(time.DateTime),
+		HasTime:                template.HasTime,
+		DurationMinutes:        template.DurationMinutes,
+		RecurrenceRrule:        template.RecurrenceRrule,
+		RecurrenceMode:         template.RecurrenceMode,
+		RecurrenceIntervalDays: template.RecurrenceIntervalDays,
+		Priority:               template.Priority,
+		Exdates:                make([]string, len(template.Exdates)),
+		Rdates:                 make([]string, len(template.Rdates)),
+		CreatedAt:              template.CreatedAt.Format(time.RFC3339),
+	}
+
+	for i, exdate := range template.Exdates {
+		output.Exdates[i] = exdate.Format(time.DateOnly)
+	}
+	for i, rdate := range template.Rdates {
+		output.Rdates[i] = rdate.Format(time.DateOnly)
+	}
+
+	return output
+}
+
+func formatExportID(id int32) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(id))
+}
+
+func writeExportJSON(archive *zip.Writer, name string, records any) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("couldn't add %s to export archive: %w", name, err)
+	}
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(records); err != nil {
+		return fmt.Errorf("couldn't write %s to export archive: %w", name, err)
+	}
+
+	return nil
+}
+
+func writeExportCSV(archive *zip.Writer, name string, rows [][]string) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("couldn't add %s to export archive: %w", name, err)
+	}
+
+	if err = csv.NewWriter(file).WriteAll(rows); err != nil {
+		return fmt.Errorf("couldn't write %s to export archive: %w", name, err)
+	}
+
+	return nil
+}
+
+// writeFileAtomically writes data next to path first, so a download never sees a half written file.
+func writeFileAtomically(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("couldn't create export directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("couldn't write export file: %w", err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("couldn't move export file in place: %w", err)
+	}
+
+	return nil
+}
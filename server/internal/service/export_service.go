@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type exportService struct {
+	repo                          repo.Querier
+	asynq                         *asynq.Client
+	dir                           string
+	userService                   domain.UserService
+	goalService                   domain.GoalService
+	taskService                   domain.TaskService
+	recurringTasksTemplateService domain.RecurringTasksTemplateService
+}
+
+func NewExportService(repo repo.Querier, asynq *asynq.Client, dir string, userService domain.UserService, goalService domain.GoalService, taskService domain.TaskService, recurringTasksTemplateService domain.RecurringTasksTemplateService) domain.ExportService {
+	return &exportService{
+		repo:                          repo,
+		asynq:                         asynq,
+		dir:                           dir,
+		userService:                   userService,
+		goalService:                   goalService,
+		taskService:                   taskService,
+		recurringTasksTemplateService: recurringTasksTemplateService,
+	}
+}
+
+func (s *exportService) CreateExportJob(ctx context.Context, userId int32) (*domain.ExportJobOutput, error) {
+	job, err := s.repo.CreateExportJob(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create export job: %w", err)
+	}
+
+	_, err = s.asynq.Enqueue(domain.NewExportUserDataTask(&domain.ExportJobPayload{
+		JobID:  job.ID,
+		UserID: userId,
+	}), asynq.Queue("low"))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't enqueue export job: %w", err)
+	}
+
+	return domain.ToExportJobOutput(&job), nil
+}
+
+func (s *exportService) GetExportJobByID(ctx context.Context, id int64, userId int32) (*domain.ExportJobOutput, error) {
+	job, err := s.repo.GetExportJobByID(ctx, repo.GetExportJobByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get export job by id: %w", err)
+	}
+
+	return domain.ToExportJobOutput(&job), nil
+}
+
+// ExportUserData writes zip archive with goals, tasks, recurring tasks templates and settings of user to export directory.
+func (s *exportService) ExportUserData(ctx context.Context, payload domain.ExportJobPayload) error {
+	err := s.repo.StartExportJobByID(ctx, payload.JobID)
+	if err != nil {
+		return fmt.Errorf("couldn't start export job: %w", err)
+	}
+
+	archive, err := s.buildExportArchiveInternal(ctx, payload.UserID)
+	if err != nil {
+		return s.failExportJobInternal(ctx, payload.JobID, err)
+	}
+
+	path := filepath.Join(s.dir, fmt.Sprintf("export-%d-%d.zip", payload.UserID, payload.JobID))
+	err = writeFileAtomically(path, archive)
+	if err != nil {
+		return s.failExportJobInternal(ctx, payload.JobID, err)
+	}
+
+	err = s.repo.FinishExportJobByID(ctx, repo.FinishExportJobByIDParams{
+		ID:     payload.JobID,
+		Status: repo.ExportJobStatusCompleted,
+		FilePath: pgtype.Text{
+			String: path,
+			Valid:  true,
+		},
+	})
+	if err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("couldn't finish export job: %w", err)
+	}
+
+	return nil
+}
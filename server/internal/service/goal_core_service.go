@@ -23,5 +23,22 @@ func (s *goalService) createGoalInternal(ctx context.Context, qtx repo.Querier,
 		return nil, fmt.Errorf("couldn't create new goal: %w", err)
 	}
 
-	return domain.ToGoalOutput(&goal), nil
+	return domain.ToGoalOutput(&goal, repo.GoalMemberRoleOwner), nil
+}
+
+func (s *goalService) listGoalsByIsArchivedInternal(ctx context.Context, isArchived bool, userId int32) ([]repo.ListGoalsRow, error) {
+	rows, err := s.repo.ListGoalsByIsArchived(ctx, repo.ListGoalsByIsArchivedParams{
+		IsArchived: isArchived,
+		UserID:     userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	goals := make([]repo.ListGoalsRow, len(rows))
+	for i, row := range rows {
+		goals[i] = repo.ListGoalsRow(row)
+	}
+
+	return goals, nil
 }
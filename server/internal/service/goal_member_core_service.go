@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// getGoalRoleInternal returns role of user in goal, users who are not members get GoalAccessDeniedError.
+func (s *goalMemberService) getGoalRoleInternal(ctx context.Context, goalId int64, userId int32) (string, error) {
+	role, err := s.repo.GetRoleInGoalMemberByIDs(ctx, repo.GetRoleInGoalMemberByIDsParams{
+		GoalID: int32(goalId),
+		UserID: userId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.GoalAccessDeniedError
+	}
+	if err != nil {
+		return "", fmt.Errorf("couldn't get goal member role: %w", err)
+	}
+
+	return string(role), nil
+}
+
+func (s *goalMemberService) getReceivedGoalInvitationInternal(ctx context.Context, id int64, userId int32) (*repo.GoalInvitation, error) {
+	user, err := s.userService.GetUserByID(ctx, int64(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.repo.GetGoalInvitationByIDAndEmail(ctx, repo.GetGoalInvitationByIDAndEmailParams{
+		ID:    id,
+		Email: user.Email,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get goal invitation by id: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+func (s *goalMemberService) deleteGoalInvitationInternal(ctx context.Context, qtx repo.Querier, id int64, goalId int32) error {
+	removed, err := qtx.DeleteGoalInvitationByID(ctx, repo.DeleteGoalInvitationByIDParams{
+		ID:     id,
+		GoalID: goalId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete goal invitation by id: %w", err)
+	}
+
+	if removed == 0 {
+		return fmt.Errorf("couldn't delete goal invitation by id: %w", pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (s *goalMemberService) sendGoalInvitationEmailInternal(ctx context.Context, inviterEmail string, invitation repo.GoalInvitation) {
+	if s.mailer == nil {
+		return
+	}
+
+	goal, err := s.repo.GetGoalByID(ctx, repo.GetGoalByIDParams{
+		ID:     int64(invitation.GoalID),
+		UserID: invitation.InvitedBy,
+	})
+	if err != nil {
+		slog.Error("failed to get goal for invitation email", "invitation_id", invitation.ID, "error", err)
+		return
+	}
+
+	err = s.mailer.Send(ctx, domain.EmailMessage{
+		To:       invitation.Email,
+		Subject:  fmt.Sprintf("You are invited to %q on Mile-Do", goal.Goal.Title),
+		Template: domain.EmailTemplateInvitation,
+		Data: domain.InvitationEmailData{
+			GoalTitle: goal.Goal.Title,
+			InvitedBy: inviterEmail,
+			Role:      string(invitation.Role),
+		},
+	})
+	if err != nil {
+		// the invitation stays valid and is listed in the app, so a failed email doesn't fail inviting
+		slog.Error("failed to send goal invitation email", "invitation_id", invitation.ID, "error", err)
+	}
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type goalMemberService struct {
+	repo        repo.Querier
+	pool        *pgxpool.Pool
+	userService domain.UserService
+	mailer      domain.Mailer
+}
+
+// NewGoalMemberService creates service sharing goals between users, without mailer invitations are only listed in the app.
+func NewGoalMemberService(repo repo.Querier, pool *pgxpool.Pool, userService domain.UserService, mailer domain.Mailer) domain.GoalMemberService {
+	return &goalMemberService{
+		repo:        repo,
+		pool:        pool,
+		userService: userService,
+		mailer:      mailer,
+	}
+}
+
+// AuthorizeGoal returns GoalAccessDeniedError unless user is member of goal with at least requiredRole.
+func (s *goalMemberService) AuthorizeGoal(ctx context.Context, goalId int64, userId int32, requiredRole string) error {
+	role, err := s.getGoalRoleInternal(ctx, goalId, userId)
+	if err != nil {
+		return err
+	}
+
+	if !domain.IsGoalRoleAtLeast(role, requiredRole) {
+		return domain.GoalAccessDeniedError
+	}
+
+	return nil
+}
+
+// AuthorizeGoalItemChange allows changing task or template to its owner and to editors of goal it belongs to.
+func (s *goalMemberService) AuthorizeGoalItemChange(ctx context.Context, goalId int32, ownerId int32, userId int32) error {
+	if ownerId == userId {
+		return nil
+	}
+
+	if goalId == 0 {
+		return domain.GoalAccessDeniedError
+	}
+
+	return s.AuthorizeGoal(ctx, int64(goalId), userId, domain.GoalRoleEditor)
+}
+
+// AuthorizeAssignees returns InvalidAssigneeError unless every assignee is owner of task or template, or member of goal it belongs to.
+func (s *goalMemberService) AuthorizeAssignees(ctx context.Context, goalId int32, ownerId int32, assigneeIds []int32) error {
+	for _, assigneeId := range assigneeIds {
+		if assigneeId == ownerId {
+			continue
+		}
+
+		if goalId == 0 {
+			return domain.InvalidAssigneeError
+		}
+
+		_, err := s.getGoalRoleInternal(ctx, int64(goalId), assigneeId)
+		if errors.Is(err, domain.GoalAccessDeniedError) {
+			return domain.InvalidAssigneeError
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *goalMemberService) ListGoalMembers(ctx context.Context, goalId int64, userId int32) ([]domain.GoalMemberOutput, error) {
+	err := s.AuthorizeGoal(ctx, goalId, userId, domain.GoalRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.ListGoalMembersByGoalID(ctx, int32(goalId))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get goal members: %w", err)
+	}
+
+	return domain.ToGoalMemberOutputList(members), nil
+}
+
+func (s *goalMemberService) UpdateGoalMember(ctx context.Context, input domain.UpdateGoalMemberInput) error {
+	err := s.AuthorizeGoal(ctx, input.GoalID, input.UserID, domain.GoalRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	if input.MemberID == input.UserID {
+		return domain.GoalOwnerMemberError
+	}
+
+	_, err = s.repo.UpdateRoleInGoalMemberByIDs(ctx, repo.UpdateRoleInGoalMemberByIDsParams{
+		GoalID: int32(input.GoalID),
+		UserID: input.MemberID,
+		Role:   repo.GoalMemberRole(input.Role),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.GoalMemberNotFoundError
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't update goal member: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveGoalMember lets owner remove any other member, and any member other than owner leave goal by removing themselves.
+func (s *goalMemberService) RemoveGoalMember(ctx context.Context, goalId int64, memberId int32, userId int32) error {
+	requiredRole := domain.GoalRoleOwner
+	if memberId == userId {
+		requiredRole = domain.GoalRoleViewer
+	}
+
+	role, err := s.getGoalRoleInternal(ctx, goalId, userId)
+	if err != nil {
+		return err
+	}
+
+	if !domain.IsGoalRoleAtLeast(role, requiredRole) {
+		return domain.GoalAccessDeniedError
+	}
+
+	if memberId == userId && role == domain.GoalRoleOwner {
+		return domain.GoalOwnerMemberError
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	removed, err := qtx.DeleteGoalMemberByIDs(ctx, repo.DeleteGoalMemberByIDsParams{
+		GoalID: int32(goalId),
+		UserID: memberId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't remove goal member: %w", err)
+	}
+
+	if removed == 0 {
+		return domain.GoalMemberNotFoundError
+	}
+
+	// former member can't see the goal anymore, so its tasks and rotations go back to the rest of members
+	err = qtx.UnassignTasksByGoalID(ctx, repo.UnassignTasksByGoalIDParams{
+		GoalID:     int32(goalId),
+		AssigneeID: memberId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't unassign tasks of removed goal member: %w", err)
+	}
+
+	err = qtx.RemoveAssigneeFromRecurringTasksTemplatesByGoalID(ctx, repo.RemoveAssigneeFromRecurringTasksTemplatesByGoalIDParams{
+		AssigneeID: memberId,
+		GoalID:     int32(goalId),
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't remove goal member from recurring tasks templates: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("couldn't commit transaction for removing goal member: %w", err)
+	}
+
+	return nil
+}
+
+// InviteGoalMember invites email to goal, invitation is emailed when mailer is configured and accepted by user signed in with that email.
+func (s *goalMemberService) InviteGoalMember(ctx context.Context, input domain.InviteGoalMemberInput) (*domain.GoalInvitationOutput, error) {
+	err := s.AuthorizeGoal(ctx, input.GoalID, input.UserID, domain.GoalRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	inviter, err := s.userService.GetUserByID(ctx, int64(input.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(inviter.Email, input.Email) {
+		return nil, domain.InvalidGoalInvitationError
+	}
+
+	invitee, err := s.userService.GetUserByEmail(ctx, input.Email)
+	if err == nil {
+		_, err = s.getGoalRoleInternal(ctx, input.GoalID, int32(invitee.ID))
+		if err == nil {
+			return nil, domain.GoalMemberExistsError
+		}
+	}
+
+	invitation, err := s.repo.CreateGoalInvitation(ctx, repo.CreateGoalInvitationParams{
+		GoalID:    int32(input.GoalID),
+		Email:     strings.ToLower(input.Email),
+		Role:      repo.GoalMemberRole(input.Role),
+		InvitedBy: input.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create goal invitation: %w", err)
+	}
+
+	s.sendGoalInvitationEmailInternal(ctx, inviter.Email, invitation)
+
+	return domain.ToGoalInvitationOutput(&invitation), nil
+}
+
+func (s *goalMemberService) ListGoalInvitations(ctx context.Context, goalId int64, userId int32) ([]domain.GoalInvitationOutput, error) {
+	err := s.AuthorizeGoal(ctx, goalId, userId, domain.GoalRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.repo.ListGoalInvitationsByGoalID(ctx, int32(goalId))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get goal invitations: %w", err)
+	}
+
+	return domain.ToGoalInvitationOutputList(invitations), nil
+}
+
+func (s *goalMemberService) DeleteGoalInvitation(ctx context.Context, goalId int64, invitationId int64, userId int32) error {
+	err := s.AuthorizeGoal(ctx, goalId, userId, domain.GoalRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	return s.deleteGoalInvitationInternal(ctx, s.repo, invitationId, int32(goalId))
+}
+
+// ListReceivedGoalInvitations returns pending invitations sent to email of user.
+func (s *goalMemberService) ListReceivedGoalInvitations(ctx context.Context, userId int32) ([]domain.GoalInvitationOutput, error) {
+	user, err := s.userService.GetUserByID(ctx, int64(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.repo.ListGoalInvitationsByEmail(ctx, user.Email)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get goal invitations: %w", err)
+	}
+
+	return domain.ToReceivedGoalInvitationOutputList(invitations), nil
+}
+
+func (s *goalMemberService) AcceptGoalInvitation(ctx context.Context, id int64, userId int32) (*domain.GoalOutput, error) {
+	invitation, err := s.getReceivedGoalInvitationInternal(ctx, id, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	err = qtx.CreateGoalMember(ctx, repo.CreateGoalMemberParams{
+		GoalID: invitation.GoalID,
+		UserID: userId,
+		Role:   invitation.Role,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create goal member: %w", err)
+	}
+
+	err = s.deleteGoalInvitationInternal(ctx, qtx, invitation.ID, invitation.GoalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for accepting goal invitation: %w", err)
+	}
+
+	goal, err := s.repo.GetGoalByID(ctx, repo.GetGoalByIDParams{
+		ID:     int64(invitation.GoalID),
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get goal by id: %w", err)
+	}
+
+	return domain.ToGoalOutput(&goal.Goal, goal.Role), nil
+}
+
+func (s *goalMemberService) DeclineGoalInvitation(ctx context.Context, id int64, userId int32) error {
+	invitation, err := s.getReceivedGoalInvitationInternal(ctx, id, userId)
+	if err != nil {
+		return err
+	}
+
+	return s.deleteGoalInvitationInternal(ctx, s.repo, invitation.ID, invitation.GoalID)
+}
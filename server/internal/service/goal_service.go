@@ -10,30 +10,27 @@ import (
 )
 
 type goalService struct {
-	repo repo.Querier
+	repo           repo.Querier
+	webhookService domain.WebhookService
 }
 
-func NewGoalService(repo repo.Querier) domain.GoalService {
+func NewGoalService(repo repo.Querier, webhookService domain.WebhookService) domain.GoalService {
 	return &goalService{
-		repo: repo,
+		repo:           repo,
+		webhookService: webhookService,
 	}
 }
 
+// ListGoals returns goals user is member of, both own and shared with user.
 func (s *goalService) ListGoals(ctx context.Context, filter string, userId int32) ([]domain.GoalOutput, error) {
-	var goals []repo.Goal
+	var goals []repo.ListGoalsRow
 	var err error
 
 	switch filter {
 	case "active":
-		goals, err = s.repo.ListGoalsByIsArchived(ctx, repo.ListGoalsByIsArchivedParams{
-			IsArchived: false,
-			UserID:     userId,
-		})
+		goals, err = s.listGoalsByIsArchivedInternal(ctx, false, userId)
 	case "archive":
-		goals, err = s.repo.ListGoalsByIsArchived(ctx, repo.ListGoalsByIsArchivedParams{
-			IsArchived: true,
-			UserID:     userId,
-		})
+		goals, err = s.listGoalsByIsArchivedInternal(ctx, true, userId)
 	case "":
 		goals, err = s.repo.ListGoals(ctx, userId)
 	default:
@@ -56,15 +53,23 @@ func (s *goalService) GetGoalByID(ctx context.Context, id int64, userId int32) (
 		return nil, fmt.Errorf("couldn't get goal by id: %w", err)
 	}
 
-	return domain.ToGoalOutput(&goal), nil
+	return domain.ToGoalOutput(&goal.Goal, goal.Role), nil
 }
 
+// CreateGoal creates goal, only goals created outside of a transaction, i.e. by user directly, are sent to webhooks.
 func (s *goalService) CreateGoal(ctx context.Context, qtx repo.Querier, input domain.CreateGoalInput) (*domain.GoalOutput, error) {
-	if qtx == nil {
-		return s.createGoalInternal(ctx, s.repo, input)
+	if qtx != nil {
+		return s.createGoalInternal(ctx, qtx, input)
 	}
 
-	return s.createGoalInternal(ctx, qtx, input)
+	goal, err := s.createGoalInternal(ctx, s.repo, input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.webhookService.DispatchEvent(ctx, goal.UserID, domain.WebhookEventGoalCreated, domain.ToWebhookGoalData(goal))
+
+	return goal, nil
 }
 
 func (s *goalService) UpdateGoal(ctx context.Context, input domain.UpdateGoalInput) (*domain.GoalOutput, error) {
@@ -85,7 +90,12 @@ func (s *goalService) UpdateGoal(ctx context.Context, input domain.UpdateGoalInp
 		return nil, fmt.Errorf("couldn't update goal: %w", err)
 	}
 
-	return domain.ToGoalOutput(&goal), nil
+	// only owner can update goal, see UpdateGoalByID
+	outGoal := domain.ToGoalOutput(&goal, repo.GoalMemberRoleOwner)
+
+	s.webhookService.DispatchEvent(ctx, outGoal.UserID, domain.WebhookEventGoalUpdated, domain.ToWebhookGoalData(outGoal))
+
+	return outGoal, nil
 }
 
 func (s *goalService) DeleteGoalByID(ctx context.Context, id int64, userId int32) error {
@@ -97,5 +107,7 @@ func (s *goalService) DeleteGoalByID(ctx context.Context, id int64, userId int32
 		return fmt.Errorf("couldn't delete goal by id: %w", err)
 	}
 
+	s.webhookService.DispatchEvent(ctx, userId, domain.WebhookEventGoalDeleted, domain.WebhookDeletedData{ID: id})
+
 	return nil
 }
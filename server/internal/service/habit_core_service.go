@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/teambition/rrule-go"
+)
+
+// habitRange is a validated range of days together with the user's time zone and today.
+type habitRange struct {
+	userId     int32
+	afterDate  time.Time
+	beforeDate time.Time
+	today      time.Time
+	loc        *time.Location
+}
+
+func (s *habitService) resolveHabitRangeInternal(ctx context.Context, input domain.GetHabitsInput) (habitRange, error) {
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(input.UserID))
+	if err != nil {
+		return habitRange{}, err
+	}
+
+	output := habitRange{
+		userId:     input.UserID,
+		afterDate:  input.AfterDate,
+		beforeDate: input.BeforeDate,
+		today:      dateInLocation(time.Now(), loc),
+		loc:        loc,
+	}
+
+	if output.beforeDate.IsZero() {
+		output.beforeDate = output.today
+	}
+	if output.afterDate.IsZero() {
+		output.afterDate = output.beforeDate.AddDate(0, 0, -(defaultHabitDays - 1))
+	}
+
+	if output.afterDate.After(output.beforeDate) {
+		return habitRange{}, fmt.Errorf("%w: after_date is later than before_date", domain.InvalidHabitRangeError)
+	}
+	if output.beforeDate.Sub(output.afterDate) >= maxHabitDays*24*time.Hour {
+		return habitRange{}, fmt.Errorf("%w: range is longer than %d days", domain.InvalidHabitRangeError, maxHabitDays)
+	}
+
+	return output, nil
+}
+
+func (s *habitService) listHabitTasksInternal(ctx context.Context, habitRange habitRange, templateId int32) ([]domain.TaskOutput, error) {
+	tasks, err := s.repo.ListRecurringTasksByDateRange(ctx, repo.ListRecurringTasksByDateRangeParams{
+		UserID: habitRange.userId,
+		RecurringTemplateID: pgtype.Int4{
+			Int32: templateId,
+			Valid: templateId != 0,
+		},
+		AfterDate: pgtype.Date{
+			Time:  habitRange.afterDate,
+			Valid: true,
+		},
+		BeforeDate: pgtype.Date{
+			Time:  habitRange.beforeDate,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get recurring tasks by date range: %w", err)
+	}
+
+	return domain.ToTaskOutputList(tasks), nil
+}
+
+// toHabitOutput lays out every day of range. A day with a done task is done, a day with an open task or
+// an occurrence of the rule is missed once it's over, any other day is unscheduled.
+func toHabitOutput(template domain.RecurringTasksTemplateOutput, tasks []domain.TaskOutput, habitRange habitRange) (*domain.HabitOutput, error) {
+	scheduled := make(map[time.Time]bool)
+	if template.RecurrenceMode != string(repo.RecurrenceModeAfterCompletion) {
+		dates, err := habitOccurrenceDates(template, habitRange)
+		if err != nil {
+			return nil, err
+		}
+		for _, date := range dates {
+			scheduled[date] = true
+		}
+	}
+
+	tasksByDate := make(map[time.Time][]domain.TaskOutput)
+	for _, task := range tasks {
+		tasksByDate[task.ScheduledDate] = append(tasksByDate[task.ScheduledDate], task)
+	}
+
+	output := &domain.HabitOutput{
+		TemplateID:     template.ID,
+		GoalID:         template.GoalID,
+		Title:          template.Title,
+		RecurrenceMode: template.RecurrenceMode,
+		AfterDate:      habitRange.afterDate,
+		BeforeDate:     habitRange.beforeDate,
+	}
+
+	for date := habitRange.afterDate; !date.After(habitRange.beforeDate); date = date.AddDate(0, 0, 1) {
+		day := domain.HabitDayOutput{
+			Date:   date,
+			Status: domain.HabitDayUnscheduled,
+		}
+
+		for _, task := range tasksByDate[date] {
+			day.TaskID = task.ID
+			if task.IsDone {
+				day.Status = domain.HabitDayDone
+				break
+			}
+		}
+
+		if day.Status != domain.HabitDayDone && (day.TaskID != 0 || scheduled[date]) {
+			day.Status = domain.HabitDayPending
+			if date.Before(habitRange.today) {
+				day.Status = domain.HabitDayMissed
+			}
+		}
+
+		switch day.Status {
+		case domain.HabitDayDone:
+			output.DoneDays++
+		case domain.HabitDayMissed:
+			output.MissedDays++
+		}
+
+		output.Days = append(output.Days, day)
+	}
+
+	if output.DoneDays+output.MissedDays > 0 {
+		output.CompletionRate = float64(output.DoneDays) / float64(output.DoneDays+output.MissedDays)
+	}
+
+	return output, nil
+}
+
+// habitOccurrenceDates returns the days of range on which the rule of a calendar-based template falls.
+func habitOccurrenceDates(template domain.RecurringTasksTemplateOutput, habitRange habitRange) ([]time.Time, error) {
+	rule, err := rrule.StrToRRuleSet(template.RecurrenceRrule)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse rrule from template: %w", err)
+	}
+
+	startDatetime := wallClockInLocation(template.ScheduledDatetime, habitRange.loc)
+	rule.DTStart(startDatetime)
+
+	for _, exdate := range template.Exdates {
+		rule.ExDate(occurrenceOnDate(exdate, startDatetime))
+	}
+	for _, rdate := range template.Rdates {
+		rule.RDate(occurrenceOnDate(rdate, startDatetime))
+	}
+
+	after := wallClockInLocation(habitRange.afterDate, habitRange.loc)
+	before := wallClockInLocation(habitRange.beforeDate, habitRange.loc).AddDate(0, 0, 1).Add(-1 * time.Second)
+
+	occurrences := rule.Between(after, before, true)
+	dates := make([]time.Time, len(occurrences))
+	for i, occurrence := range occurrences {
+		dates[i] = dateInLocation(occurrence, habitRange.loc)
+	}
+
+	return dates, nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+const (
+	// defaultHabitDays is the length of the range ending today used when no dates are given.
+	defaultHabitDays = 30
+	maxHabitDays     = 366
+)
+
+type habitService struct {
+	repo                          repo.Querier
+	userService                   domain.UserService
+	taskService                   domain.TaskService
+	recurringTasksTemplateService domain.RecurringTasksTemplateService
+}
+
+func NewHabitService(repo repo.Querier, userService domain.UserService, taskService domain.TaskService, recurringTasksTemplateService domain.RecurringTasksTemplateService) domain.HabitService {
+	return &habitService{
+		repo:                          repo,
+		userService:                   userService,
+		taskService:                   taskService,
+		recurringTasksTemplateService: recurringTasksTemplateService,
+	}
+}
+
+// ListHabits builds completion grid of every recurring tasks template of user for range.
+func (s *habitService) ListHabits(ctx context.Context, input domain.GetHabitsInput) ([]domain.HabitOutput, error) {
+	habitRange, err := s.resolveHabitRangeInternal(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.recurringTasksTemplateService.ListRecurringTasksTemplates(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.listHabitTasksInternal(ctx, habitRange, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	tasksByTemplate := make(map[int32][]domain.TaskOutput)
+	for _, task := range tasks {
+		tasksByTemplate[task.RecurringTemplateID] = append(tasksByTemplate[task.RecurringTemplateID], task)
+	}
+
+	habits := make([]domain.HabitOutput, len(templates))
+	for i, template := range templates {
+		habit, err := toHabitOutput(template, tasksByTemplate[int32(template.ID)], habitRange)
+		if err != nil {
+			return nil, err
+		}
+		habits[i] = *habit
+	}
+
+	return habits, nil
+}
+
+func (s *habitService) GetHabitByID(ctx context.Context, templateId int64, input domain.GetHabitsInput) (*domain.HabitOutput, error) {
+	habitRange, err := s.resolveHabitRangeInternal(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.recurringTasksTemplateService.GetRecurringTasksTemplateByID(ctx, templateId, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.listHabitTasksInternal(ctx, habitRange, int32(templateId))
+	if err != nil {
+		return nil, err
+	}
+
+	return toHabitOutput(*template, tasks, habitRange)
+}
+
+// CheckInHabit marks a day up to today as done. Calendar-based templates can only be checked in on their
+// occurrences, and only the task of that day is created instead of materializing the whole series.
+func (s *habitService) CheckInHabit(ctx context.Context, input domain.HabitCheckInInput) (*domain.TaskOutput, error) {
+	template, err := s.recurringTasksTemplateService.GetRecurringTasksTemplateByID(ctx, input.TemplateID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	habitRange, err := s.resolveHabitRangeInternal(ctx, domain.GetHabitsInput{
+		UserID:     input.UserID,
+		AfterDate:  input.Date,
+		BeforeDate: input.Date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Date.After(habitRange.today) {
+		return nil, fmt.Errorf("%w: %s is in the future", domain.InvalidHabitCheckInDateError, input.Date.Format("2006-01-02"))
+	}
+
+	tasks, err := s.listHabitTasksInternal(ctx, habitRange, int32(input.TemplateID))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tasks) == 0 && template.RecurrenceMode != string(repo.RecurrenceModeAfterCompletion) {
+		dates, err := habitOccurrenceDates(*template, habitRange)
+		if err != nil {
+			return nil, err
+		}
+		if len(dates) == 0 {
+			return nil, fmt.Errorf("%w: %s is not an occurrence", domain.InvalidHabitCheckInDateError, input.Date.Format("2006-01-02"))
+		}
+	}
+
+	return s.taskService.CheckInRecurringTask(ctx, *template, input.Date)
+}
+
+// UndoHabitCheckIn reopens the done task of day, a day without one is left as it is.
+func (s *habitService) UndoHabitCheckIn(ctx context.Context, input domain.HabitCheckInInput) error {
+	habitRange, err := s.resolveHabitRangeInternal(ctx, domain.GetHabitsInput{
+		UserID:     input.UserID,
+		AfterDate:  input.Date,
+		BeforeDate: input.Date,
+	})
+	if err != nil {
+		return err
+	}
+
+	tasks, err := s.listHabitTasksInternal(ctx, habitRange, int32(input.TemplateID))
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if !task.IsDone {
+			continue
+		}
+
+		_, err = s.taskService.UncompleteTask(ctx, task)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
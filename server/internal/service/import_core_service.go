@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/ali-nur31/mile-do/pkg/ical"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	defaultImportDurationMinutes = 15
+	untitledImportTitle          = "Untitled"
+)
+
+// icsImportItem is a calendar component mapped to either a task or a recurring tasks template.
+type icsImportItem struct {
+	title     string
+	task      *domain.CreateTaskInput
+	template  *domain.CreateRecurringTasksTemplateInput
+	completed bool
+}
+
+func (s *importService) importIcsItemInternal(ctx context.Context, item icsImportItem) error {
+	if item.template != nil {
+		if _, err := rrule.StrToRRuleSet(item.template.RecurrenceRrule); err != nil {
+			return fmt.Errorf("unsupported recurrence rule: %w", err)
+		}
+
+		_, err := s.recurringTasksTemplateService.CreateRecurringTasksTemplate(ctx, *item.template)
+		return err
+	}
+
+	task, err := s.taskService.CreateTask(ctx, *item.task)
+	if err != nil {
+		return err
+	}
+
+	if item.completed {
+		_, err = s.taskService.CompleteTask(ctx, item.task.UserID, task.ID, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *importService) failImportJobInternal(ctx context.Context, jobId int64, cause error) error {
+	err := s.repo.FinishImportJobByID(ctx, repo.FinishImportJobByIDParams{
+		ID:     jobId,
+		Status: repo.ImportJobStatusFailed,
+		Error: pgtype.Text{
+			String: cause.Error(),
+			Valid:  true,
+		},
+	})
+	if err != nil {
+		return errors.Join(cause, fmt.Errorf("couldn't fail import job: %w", err))
+	}
+
+	return cause
+}
+
+// toIcsImportItems maps events and to-dos of calendar, times are converted to wall clock time of user.
+// Modified occurrences (RECURRENCE-ID) become standalone tasks and are excluded from their series.
+func toIcsImportItems(calendar *ical.Calendar, payload domain.ImportJobPayload, loc *time.Location) []icsImportItem {
+	overridden := make(map[string][]time.Time)
+	for _, event := range calendar.Events {
+		if !event.RecurrenceID.IsZero() {
+			overridden[event.UID] = append(overridden[event.UID], wallClockDate(event.RecurrenceID, event.AllDay || event.Floating, loc))
+		}
+	}
+
+	var items []icsImportItem
+	for _, event := range calendar.Events {
+		keepsZone := event.AllDay || event.Floating
+		start := wallClock(event.Start, keepsZone, loc)
+		title := importTitle(event.Summary)
+
+		duration := int32(defaultImportDurationMinutes)
+		if !event.AllDay && event.End.After(event.Start) {
+			duration = int32(event.End.Sub(event.Start) / time.Minute)
+		}
+
+		if len(event.RRules) > 0 && event.RecurrenceID.IsZero() {
+			exdates := overridden[event.UID]
+			for _, exdate := range event.ExDates {
+				exdates = append(exdates, wallClockDate(exdate, keepsZone, loc))
+			}
+
+			rdates := make([]time.Time, len(event.RDates))
+			for i, rdate := range event.RDates {
+				rdates[i] = wallClockDate(rdate, keepsZone, loc)
+			}
+
+			items = append(items, icsImportItem{
+				title: title,
+				template: &domain.CreateRecurringTasksTemplateInput{
+					UserID:            payload.UserID,
+					GoalID:            payload.GoalID,
+					Title:             title,
+					ScheduledDatetime: start,
+					HasTime:           !event.AllDay,
+					DurationMinutes:   duration,
+					RecurrenceRrule:   toRecurrenceRrule(event.RRules),
+					Priority:          fromCalendarPriority(event.Priority),
+					Exdates:           exdates,
+					Rdates:            rdates,
+				},
+			})
+			continue
+		}
+
+		items = append(items, icsImportItem{
+			title: title,
+			task: &domain.CreateTaskInput{
+				UserID:          payload.UserID,
+				GoalID:          payload.GoalID,
+				Title:           title,
+				ScheduledDate:   dateInLocation(start, time.UTC),
+				ScheduledTime:   start,
+				HasTime:         !event.AllDay,
+				DurationMinutes: duration,
+				Priority:        fromCalendarPriority(event.Priority),
+			},
+		})
+	}
+
+	for _, todo := range calendar.Todos {
+		title := importTitle(todo.Summary)
+		task := &domain.CreateTaskInput{
+			UserID:          payload.UserID,
+			GoalID:          payload.GoalID,
+			Title:           title,
+			DurationMinutes: defaultImportDurationMinutes,
+			Priority:        fromCalendarPriority(todo.Priority),
+		}
+
+		// a to-do without due date goes to inbox
+		if !todo.Due.IsZero() {
+			due := wallClock(todo.Due, todo.AllDay || todo.Floating, loc)
+
+			if len(todo.RRules) > 0 {
+				items = append(items, icsImportItem{
+					title: title,
+					template: &domain.CreateRecurringTasksTemplateInput{
+						UserID:            payload.UserID,
+						GoalID:            payload.GoalID,
+						Title:             title,
+						ScheduledDatetime: due,
+						HasTime:           !todo.AllDay,
+						DurationMinutes:   defaultImportDurationMinutes,
+						RecurrenceRrule:   toRecurrenceRrule(todo.RRules),
+						Priority:          fromCalendarPriority(todo.Priority),
+					},
+				})
+				continue
+			}
+
+			task.ScheduledDate = dateInLocation(due, time.UTC)
+			task.ScheduledTime = due
+			task.HasTime = !todo.AllDay
+		}
+
+		items = append(items, icsImportItem{
+			title:     title,
+			task:      task,
+			completed: todo.Completed,
+		})
+	}
+
+	return items
+}
+
+// wallClock returns t as wall clock time of user in the UTC form used by templates and tasks.
+// Dates and floating times have no zone and are kept as they are.
+func wallClock(t time.Time, keepsZone bool, loc *time.Location) time.Time {
+	if !keepsZone {
+		t = t.In(loc)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+func wallClockDate(t time.Time, keepsZone bool, loc *time.Location) time.Time {
+	return dateInLocation(wallClock(t, keepsZone, loc), time.UTC)
+}
+
+func toRecurrenceRrule(rules []string) string {
+	lines := make([]string, len(rules))
+	for i, rule := range rules {
+		lines[i] = "RRULE:" + rule
+	}
+	return strings.Join(lines, "\n")
+}
+
+func importTitle(summary string) string {
+	title := strings.TrimSpace(summary)
+	if title == "" {
+		return untitledImportTitle
+	}
+	if len([]rune(title)) > 256 {
+		return string([]rune(title)[:256])
+	}
+	return title
+}
+
+// fromCalendarPriority maps iCalendar PRIORITY, where 1 is the highest and 0 is undefined.
+func fromCalendarPriority(priority int) string {
+	switch {
+	case priority >= 1 && priority <= 4:
+		return string(repo.TaskPriorityHigh)
+	case priority == 5:
+		return string(repo.TaskPriorityMedium)
+	case priority >= 6 && priority <= 9:
+		return string(repo.TaskPriorityLow)
+	default:
+		return string(repo.TaskPriorityNone)
+	}
+}
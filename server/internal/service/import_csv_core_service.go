@@ -0,0 +1,558 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	defaultImportList      = "Inbox"
+	importGoalColor        = "#0096ff"
+	tickTickTimeLayout     = "2006-01-02T15:04:05-0700"
+	tickTickStatusComplete = "1"
+	tickTickStatusArchived = "2"
+)
+
+// csvImportRow is a task of TickTick or Todoist backup in the form shared by both sources.
+// Its date is wall clock time of user in the UTC form used by templates and tasks, zero for inbox tasks.
+type csvImportRow struct {
+	line         int
+	list         string
+	title        string
+	date         time.Time
+	hasTime      bool
+	duration     int32
+	priority     string
+	rrule        string
+	mode         string
+	intervalDays int32
+	completed    bool
+}
+
+func (r csvImportRow) recurring() bool {
+	return r.rrule != "" || r.intervalDays > 0
+}
+
+type csvRecord struct {
+	line   int
+	fields []string
+}
+
+// csvColumns maps upper-cased header names to their positions.
+type csvColumns map[string]int
+
+func (c csvColumns) value(record csvRecord, name string) string {
+	i, ok := c[name]
+	if !ok || i >= len(record.fields) {
+		return ""
+	}
+	return strings.TrimSpace(record.fields[i])
+}
+
+func (s *importService) importCsvRowInternal(ctx context.Context, qtx repo.Querier, userId int32, goalId int32, row csvImportRow) error {
+	if !row.recurring() {
+		input := domain.CreateTaskInput{
+			UserID:          userId,
+			GoalID:          goalId,
+			Title:           row.title,
+			DurationMinutes: row.duration,
+			Priority:        row.priority,
+		}
+
+		if !row.date.IsZero() {
+			input.ScheduledDate = dateInLocation(row.date, time.UTC)
+			input.ScheduledTime = row.date
+			input.HasTime = row.hasTime
+		}
+
+		_, err := s.taskService.ImportTask(ctx, qtx, input, row.completed)
+		return err
+	}
+
+	template, err := s.recurringTasksTemplateService.ImportRecurringTasksTemplate(ctx, qtx, domain.CreateRecurringTasksTemplateInput{
+		UserID:                 userId,
+		GoalID:                 goalId,
+		Title:                  row.title,
+		ScheduledDatetime:      row.date,
+		HasTime:                row.hasTime,
+		DurationMinutes:        row.duration,
+		RecurrenceRrule:        row.rrule,
+		RecurrenceMode:         row.mode,
+		RecurrenceIntervalDays: row.intervalDays,
+		Priority:               row.priority,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.taskService.CreateTasksByRecurringTasksTemplate(ctx, qtx, *template)
+}
+
+// parseCsvImportRows reads rows of backup, rows that can't be mapped are reported instead of failing the whole file.
+func parseCsvImportRows(input domain.ImportCsvInput, loc *time.Location) ([]csvImportRow, []domain.ImportRowError, error) {
+	records, err := readCsvRecords(input.Content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch input.Source {
+	case domain.ImportSourceTickTick:
+		return parseTickTickRows(records, loc)
+	case domain.ImportSourceTodoist:
+		// Todoist exports one project per file, named after the project
+		list := strings.TrimSuffix(filepath.Base(input.FileName), filepath.Ext(input.FileName))
+		return parseTodoistRows(records, list, loc)
+	default:
+		return nil, nil, fmt.Errorf("unsupported import source %q", input.Source)
+	}
+}
+
+func readCsvRecords(content []byte) ([]csvRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var records []csvRecord
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		records = append(records, csvRecord{
+			line:   line,
+			fields: fields,
+		})
+	}
+
+	return records, nil
+}
+
+// findCsvHeader returns the columns of the first record holding all required names and its position,
+// TickTick puts a few lines of metadata before its header.
+func findCsvHeader(records []csvRecord, required ...string) (csvColumns, int, error) {
+	for i, record := range records {
+		columns := make(csvColumns, len(record.fields))
+		for j, name := range record.fields {
+			columns[strings.ToUpper(strings.TrimSpace(name))] = j
+		}
+
+		found := true
+		for _, name := range required {
+			if _, ok := columns[name]; !ok {
+				found = false
+				break
+			}
+		}
+		if found {
+			return columns, i, nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("couldn't find csv header with columns %s", strings.Join(required, ", "))
+}
+
+func parseTickTickRows(records []csvRecord, loc *time.Location) ([]csvImportRow, []domain.ImportRowError, error) {
+	columns, header, err := findCsvHeader(records, "LIST NAME", "TITLE", "STATUS")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []csvImportRow
+	var rowErrors []domain.ImportRowError
+	for _, record := range records[header+1:] {
+		if columns.value(record, "TITLE") == "" || strings.EqualFold(columns.value(record, "KIND"), "NOTE") {
+			continue
+		}
+
+		row, err := toTickTickRow(columns, record, loc)
+		if err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{
+				Row:   record.line,
+				Error: err.Error(),
+			})
+			continue
+		}
+
+		rows = append(rows, *row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+func toTickTickRow(columns csvColumns, record csvRecord, loc *time.Location) (*csvImportRow, error) {
+	status := columns.value(record, "STATUS")
+	row := &csvImportRow{
+		line:      record.line,
+		list:      importListTitle(columns.value(record, "LIST NAME")),
+		title:     importTitle(columns.value(record, "TITLE")),
+		duration:  defaultImportDurationMinutes,
+		priority:  fromTickTickPriority(columns.value(record, "PRIORITY")),
+		completed: status == tickTickStatusComplete || status == tickTickStatusArchived,
+	}
+
+	start, err := parseTickTickTime(columns.value(record, "START DATE"))
+	if err != nil {
+		return nil, err
+	}
+	due, err := parseTickTickTime(columns.value(record, "DUE DATE"))
+	if err != nil {
+		return nil, err
+	}
+	if start.IsZero() {
+		start = due
+	}
+
+	if !start.IsZero() {
+		allDay := strings.EqualFold(columns.value(record, "IS ALL DAY"), "true")
+
+		// all-day dates are midnights of the zone the task was created in
+		zone := loc
+		if name := columns.value(record, "TIMEZONE"); allDay && name != "" {
+			if taskLoc, err := time.LoadLocation(name); err == nil {
+				zone = taskLoc
+			}
+		}
+
+		row.date = wallClock(start, false, zone)
+		row.hasTime = !allDay
+		if !allDay && due.After(start) {
+			row.duration = int32(due.Sub(start) / time.Minute)
+		}
+	}
+
+	// a finished series is kept as a single done task
+	repeat := columns.value(record, "REPEAT")
+	if repeat == "" || row.completed {
+		return row, nil
+	}
+
+	if row.date.IsZero() {
+		return nil, errors.New("repeating task has no start date")
+	}
+
+	row.rrule, err = fromTickTickRepeat(repeat)
+	if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+func parseTickTickTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{tickTickTimeLayout, time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// fromTickTickRepeat keeps the standard part of TickTick repeat rule, dropping its own TT_ extensions.
+func fromTickTickRepeat(repeat string) (string, error) {
+	if strings.HasPrefix(repeat, "ERULE:") {
+		return "", fmt.Errorf("unsupported repeat %q, custom repeat dates can't be imported", repeat)
+	}
+
+	var parts []string
+	for _, part := range strings.Split(strings.TrimPrefix(repeat, "RRULE:"), ";") {
+		if part == "" || strings.HasPrefix(strings.ToUpper(part), "TT_") {
+			continue
+		}
+		parts = append(parts, part)
+	}
+
+	recurrence := toRecurrenceRrule([]string{strings.Join(parts, ";")})
+	if _, err := rrule.StrToRRuleSet(recurrence); err != nil {
+		return "", fmt.Errorf("unsupported repeat %q: %w", repeat, err)
+	}
+
+	return recurrence, nil
+}
+
+// fromTickTickPriority maps TickTick priority, which is 0 for none, 1 for low, 3 for medium and 5 for high.
+func fromTickTickPriority(priority string) string {
+	switch priority {
+	case "5":
+		return string(repo.TaskPriorityHigh)
+	case "3":
+		return string(repo.TaskPriorityMedium)
+	case "1":
+		return string(repo.TaskPriorityLow)
+	default:
+		return string(repo.TaskPriorityNone)
+	}
+}
+
+func parseTodoistRows(records []csvRecord, list string, loc *time.Location) ([]csvImportRow, []domain.ImportRowError, error) {
+	columns, header, err := findCsvHeader(records, "TYPE", "CONTENT", "DATE")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	today := dateInLocation(time.Now(), loc)
+
+	var rows []csvImportRow
+	var rowErrors []domain.ImportRowError
+	for _, record := range records[header+1:] {
+		// sections and notes have no counterpart
+		if !strings.EqualFold(columns.value(record, "TYPE"), "task") {
+			continue
+		}
+
+		row, err := toTodoistRow(columns, record, list, today)
+		if err != nil {
+			rowErrors = append(rowErrors, domain.ImportRowError{
+				Row:   record.line,
+				Error: err.Error(),
+			})
+			continue
+		}
+
+		rows = append(rows, *row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+func toTodoistRow(columns csvColumns, record csvRecord, list string, today time.Time) (*csvImportRow, error) {
+	row := &csvImportRow{
+		line:     record.line,
+		list:     importListTitle(list),
+		title:    importTitle(columns.value(record, "CONTENT")),
+		duration: defaultImportDurationMinutes,
+		priority: fromTodoistPriority(columns.value(record, "PRIORITY")),
+	}
+
+	if duration, err := strconv.Atoi(columns.value(record, "DURATION")); err == nil && duration > 0 {
+		if strings.EqualFold(columns.value(record, "DURATION_UNIT"), "day") {
+			duration *= 24 * 60
+		}
+		row.duration = int32(duration)
+	}
+
+	date := strings.Join(strings.Fields(strings.ToLower(columns.value(record, "DATE"))), " ")
+	if date == "" {
+		return row, nil
+	}
+
+	date, clock, hasTime, err := cutTodoistTime(date)
+	if err != nil {
+		return nil, err
+	}
+	row.hasTime = hasTime
+
+	if isTodoistRecurrence(date) {
+		row.rrule, row.mode, row.intervalDays, err = fromTodoistRecurrence(date)
+		if err != nil {
+			return nil, err
+		}
+		row.date = combineDateAndTime(today, clock)
+		return row, nil
+	}
+
+	day, err := parseTodoistDate(date, today)
+	if err != nil {
+		return nil, err
+	}
+	row.date = combineDateAndTime(day, clock)
+
+	return row, nil
+}
+
+// cutTodoistTime splits `<date> at <time>` or `<date> <time>` into date and wall clock time.
+func cutTodoistTime(value string) (string, time.Time, bool, error) {
+	// ISO date-times carry their time themselves
+	for _, layout := range []string{"2006-01-02t15:04:05", "2006-01-02t15:04"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format(time.DateOnly), t, true, nil
+		}
+	}
+
+	if date, clock, found := strings.Cut(value, " at "); found {
+		t, err := parseTodoistClock(clock, true)
+		if err != nil {
+			return "", time.Time{}, false, err
+		}
+		return date, t, true, nil
+	}
+
+	if i := strings.LastIndex(value, " "); i >= 0 {
+		if t, err := parseTodoistClock(value[i+1:], false); err == nil {
+			return value[:i], t, true, nil
+		}
+	}
+
+	return value, time.Time{}, false, nil
+}
+
+// parseTodoistClock parses 24-hour and am/pm times, a bare hour only makes sense after "at".
+func parseTodoistClock(value string, bareHour bool) (time.Time, error) {
+	layouts := []string{"15:04", "3pm", "3:04pm", "3 pm", "3:04 pm"}
+	if bareHour {
+		layouts = append(layouts, "15")
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q", value)
+}
+
+func parseTodoistDate(value string, today time.Time) (time.Time, error) {
+	switch value {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	}
+
+	for _, layout := range []string{time.DateOnly, "jan 2 2006", "january 2 2006", "2 jan 2006", "2 january 2006"} {
+		if t, err := time.Parse(layout, strings.ReplaceAll(value, ",", "")); err == nil {
+			return t, nil
+		}
+	}
+
+	// dates without year are in the current one
+	for _, layout := range []string{"jan 2", "january 2", "2 jan", "2 january"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return time.Date(today.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported date %q", value)
+}
+
+func isTodoistRecurrence(value string) bool {
+	switch value {
+	case "daily", "weekly", "monthly", "yearly", "annually":
+		return true
+	}
+	return strings.HasPrefix(value, "every ") || strings.HasPrefix(value, "every! ")
+}
+
+// fromTodoistRecurrence converts Todoist recurring dates such as "every 2 weeks", "every mon, fri" or "every weekday".
+// Todoist's "every!" repeats after completion, which is supported for intervals of days and weeks.
+func fromTodoistRecurrence(value string) (string, string, int32, error) {
+	aliases := map[string]string{
+		"daily":    "every day",
+		"weekly":   "every week",
+		"monthly":  "every month",
+		"yearly":   "every year",
+		"annually": "every year",
+	}
+	if alias, ok := aliases[value]; ok {
+		value = alias
+	}
+
+	afterCompletion := strings.HasPrefix(value, "every! ")
+	words := strings.Fields(strings.NewReplacer(",", " ", " and ", " ").Replace(value))[1:]
+
+	interval := 1
+	if len(words) > 1 && words[0] == "other" {
+		interval, words = 2, words[1:]
+	} else if n, err := strconv.Atoi(words[0]); err == nil && n > 0 && len(words) > 1 {
+		interval, words = n, words[1:]
+	}
+
+	var freq, byDay string
+	days := 0
+	switch strings.Join(words, " ") {
+	case "day", "days":
+		freq, days = "DAILY", 1
+	case "week", "weeks":
+		freq, days = "WEEKLY", 7
+	case "month", "months":
+		freq = "MONTHLY"
+	case "year", "years":
+		freq = "YEARLY"
+	case "weekday", "workday":
+		freq, byDay = "WEEKLY", "MO,TU,WE,TH,FR"
+	case "weekend":
+		freq, byDay = "WEEKLY", "SA,SU"
+	default:
+		weekdays := make([]string, len(words))
+		for i, word := range words {
+			weekday, ok := todoistWeekday(word)
+			if !ok {
+				return "", "", 0, fmt.Errorf("unsupported recurring date %q", value)
+			}
+			weekdays[i] = weekday
+		}
+		freq, byDay = "WEEKLY", strings.Join(weekdays, ",")
+	}
+
+	if afterCompletion {
+		if days == 0 {
+			return "", "", 0, fmt.Errorf("unsupported recurring date %q, only days and weeks can repeat after completion", value)
+		}
+		return "", string(repo.RecurrenceModeAfterCompletion), int32(interval * days), nil
+	}
+
+	rule := fmt.Sprintf("FREQ=%s;INTERVAL=%d", freq, interval)
+	if byDay != "" {
+		rule += ";BYDAY=" + byDay
+	}
+
+	return toRecurrenceRrule([]string{rule}), string(repo.RecurrenceModeCalendar), 0, nil
+}
+
+func todoistWeekday(word string) (string, bool) {
+	weekdays := map[string]string{
+		"mon": "MO", "monday": "MO",
+		"tue": "TU", "tues": "TU", "tuesday": "TU",
+		"wed": "WE", "wednesday": "WE",
+		"thu": "TH", "thur": "TH", "thurs": "TH", "thursday": "TH",
+		"fri": "FR", "friday": "FR",
+		"sat": "SA", "saturday": "SA",
+		"sun": "SU", "sunday": "SU",
+	}
+
+	weekday, ok := weekdays[word]
+	return weekday, ok
+}
+
+// fromTodoistPriority maps Todoist priority of CSV export, where 1 is the urgent p1 and 4 is the natural p4.
+func fromTodoistPriority(priority string) string {
+	switch priority {
+	case "1":
+		return string(repo.TaskPriorityHigh)
+	case "2":
+		return string(repo.TaskPriorityMedium)
+	case "3":
+		return string(repo.TaskPriorityLow)
+	default:
+		return string(repo.TaskPriorityNone)
+	}
+}
+
+func importListTitle(list string) string {
+	if strings.TrimSpace(list) == "" {
+		return defaultImportList
+	}
+	return importTitle(list)
+}
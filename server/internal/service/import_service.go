@@ -0,0 +1,235 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/ali-nur31/mile-do/pkg/ical"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// importProgressStep is how many items are imported between progress updates of import job.
+const importProgressStep = 20
+
+type importService struct {
+	repo                          repo.Querier
+	pool                          *pgxpool.Pool
+	asynq                         *asynq.Client
+	userService                   domain.UserService
+	goalService                   domain.GoalService
+	taskService                   domain.TaskService
+	recurringTasksTemplateService domain.RecurringTasksTemplateService
+}
+
+func NewImportService(repo repo.Querier, pool *pgxpool.Pool, asynq *asynq.Client, userService domain.UserService, goalService domain.GoalService, taskService domain.TaskService, recurringTasksTemplateService domain.RecurringTasksTemplateService) domain.ImportService {
+	return &importService{
+		repo:                          repo,
+		pool:                          pool,
+		asynq:                         asynq,
+		userService:                   userService,
+		goalService:                   goalService,
+		taskService:                   taskService,
+		recurringTasksTemplateService: recurringTasksTemplateService,
+	}
+}
+
+func (s *importService) CreateImportJob(ctx context.Context, input domain.CreateImportJobInput) (*domain.ImportJobOutput, error) {
+	job, err := s.repo.CreateImportJob(ctx, repo.CreateImportJobParams{
+		UserID: input.UserID,
+		GoalID: input.GoalID,
+		Source: input.Source,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create import job: %w", err)
+	}
+
+	payload := &domain.ImportJobPayload{
+		JobID:   job.ID,
+		UserID:  input.UserID,
+		GoalID:  input.GoalID,
+		Content: input.Content,
+	}
+
+	// retrying a half done import would duplicate already imported items
+	_, err = s.asynq.Enqueue(domain.NewImportIcsTask(payload), asynq.Queue("low"), asynq.MaxRetry(0))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't enqueue import job: %w", err)
+	}
+
+	return domain.ToImportJobOutput(&job), nil
+}
+
+func (s *importService) GetImportJobByID(ctx context.Context, id int64, userId int32) (*domain.ImportJobOutput, error) {
+	job, err := s.repo.GetImportJobByID(ctx, repo.GetImportJobByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get import job by id: %w", err)
+	}
+
+	return domain.ToImportJobOutput(&job), nil
+}
+
+// ImportIcs creates tasks and recurring tasks templates from the calendar of payload.
+// Items failing on their own are reported on the job, an unreadable calendar or failed bookkeeping fails the whole job.
+func (s *importService) ImportIcs(ctx context.Context, payload domain.ImportJobPayload) error {
+	calendar, err := ical.Parse(bytes.NewReader(payload.Content))
+	if err != nil {
+		return s.failImportJobInternal(ctx, payload.JobID, fmt.Errorf("couldn't parse calendar: %w", err))
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(payload.UserID))
+	if err != nil {
+		return s.failImportJobInternal(ctx, payload.JobID, err)
+	}
+
+	items := toIcsImportItems(calendar, payload, loc)
+
+	err = s.repo.StartImportJobByID(ctx, repo.StartImportJobByIDParams{
+		ID:         payload.JobID,
+		TotalItems: int32(len(items)),
+	})
+	if err != nil {
+		return s.failImportJobInternal(ctx, payload.JobID, fmt.Errorf("couldn't start import job: %w", err))
+	}
+
+	var failed int32
+	var itemErrors []string
+	for i, item := range items {
+		err = s.importIcsItemInternal(ctx, item)
+		if err != nil {
+			failed++
+			itemErrors = append(itemErrors, fmt.Sprintf("%q: %v", item.title, err))
+		}
+
+		processed := i + 1
+		if processed%importProgressStep != 0 && processed != len(items) {
+			continue
+		}
+
+		err = s.repo.UpdateProgressInImportJobByID(ctx, repo.UpdateProgressInImportJobByIDParams{
+			ID:             payload.JobID,
+			ProcessedItems: int32(processed),
+			FailedItems:    failed,
+			ItemErrors:     itemErrors,
+		})
+		if err != nil {
+			return s.failImportJobInternal(ctx, payload.JobID, fmt.Errorf("couldn't update progress of import job: %w", err))
+		}
+	}
+
+	err = s.repo.FinishImportJobByID(ctx, repo.FinishImportJobByIDParams{
+		ID:     payload.JobID,
+		Status: repo.ImportJobStatusCompleted,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't finish import job: %w", err)
+	}
+
+	return nil
+}
+
+// ImportCsv imports tasks of TickTick or Todoist CSV backup, their lists become goals and missing goals are created.
+// Everything is written in one transaction and only when every row can be imported, dry run only reports what would happen.
+func (s *importService) ImportCsv(ctx context.Context, input domain.ImportCsvInput) (*domain.ImportCsvOutput, error) {
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(input.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, rowErrors, err := parseCsvImportRows(input, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.InvalidImportFileError, err)
+	}
+
+	goals, err := s.goalService.ListGoals(ctx, "", input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	goalIds := make(map[string]int32, len(goals))
+	for _, goal := range goals {
+		if goal.UserID != input.UserID {
+			// rows are matched only to own goals, a shared goal with the same title is left alone
+			continue
+		}
+		goalIds[strings.ToLower(goal.Title)] = int32(goal.ID)
+	}
+
+	var newGoals []string
+	for _, row := range rows {
+		key := strings.ToLower(row.list)
+		if _, ok := goalIds[key]; !ok {
+			goalIds[key] = 0
+			newGoals = append(newGoals, row.list)
+		}
+	}
+
+	output := &domain.ImportCsvOutput{
+		Source:    input.Source,
+		DryRun:    input.DryRun,
+		TotalRows: len(rows) + len(rowErrors),
+		NewGoals:  newGoals,
+		RowErrors: rowErrors,
+	}
+
+	if input.DryRun || len(rowErrors) > 0 {
+		return output, nil
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	for _, title := range newGoals {
+		goal, err := s.goalService.CreateGoal(ctx, qtx, domain.CreateGoalInput{
+			UserID:       input.UserID,
+			Title:        title,
+			Color:        importGoalColor,
+			CategoryType: string(repo.GoalsCategoryTypeOther),
+		})
+		if err != nil {
+			return nil, err
+		}
+		goalIds[strings.ToLower(title)] = int32(goal.ID)
+	}
+
+	var importedTasks, importedTemplates int
+	for _, row := range rows {
+		err = s.importCsvRowInternal(ctx, qtx, input.UserID, goalIds[strings.ToLower(row.list)], row)
+		if err != nil {
+			output.RowErrors = append(output.RowErrors, domain.ImportRowError{
+				Row:   row.line,
+				Error: err.Error(),
+			})
+			return output, nil
+		}
+
+		if row.recurring() {
+			importedTemplates++
+		} else {
+			importedTasks++
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for importing csv: %w", err)
+	}
+
+	output.ImportedTasks = importedTasks
+	output.ImportedTemplates = importedTemplates
+
+	return output, nil
+}
@@ -27,4 +27,32 @@ func (s *Scheduler) InitSchedules() {
 			slog.Error("couldn't enqueue generation of recurring tasks due for generation", "error", err)
 		}
 	})
+
+	s.cron.AddFunc("@hourly", func() {
+		_, err := s.asynq.Enqueue(domain.NewRollOverOverdueTasksTask(), asynq.Queue("low"))
+		if err != nil {
+			slog.Error("couldn't enqueue roll over of overdue tasks", "error", err)
+		}
+	})
+
+	s.cron.AddFunc("@daily", func() {
+		_, err := s.asynq.Enqueue(domain.NewGenerateWeeklyReviewsTask(), asynq.Queue("low"))
+		if err != nil {
+			slog.Error("couldn't enqueue generation of weekly reviews", "error", err)
+		}
+	})
+
+	s.cron.AddFunc("@hourly", func() {
+		_, err := s.asynq.Enqueue(domain.NewSendDailyAgendasTask(), asynq.Queue("low"))
+		if err != nil {
+			slog.Error("couldn't enqueue sending of daily agendas", "error", err)
+		}
+	})
+
+	s.cron.AddFunc("@hourly", func() {
+		_, err := s.asynq.Enqueue(domain.NewPurgeDeletedBlobsTask(), asynq.Queue("low"))
+		if err != nil {
+			slog.Error("couldn't enqueue purge of deleted blobs", "error", err)
+		}
+	})
 }
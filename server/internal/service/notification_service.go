@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type notificationService struct {
+	repo repo.Querier
+}
+
+func NewNotificationService(repo repo.Querier) domain.NotificationService {
+	return &notificationService{
+		repo: repo,
+	}
+}
+
+func (s *notificationService) ListNotifications(ctx context.Context, userId int32, unreadOnly bool) ([]domain.NotificationOutput, error) {
+	notifications, err := s.repo.ListNotifications(ctx, repo.ListNotificationsParams{
+		UserID:     userId,
+		UnreadOnly: unreadOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get notifications: %w", err)
+	}
+
+	return domain.ToNotificationOutputList(notifications), nil
+}
+
+func (s *notificationService) MarkNotificationAsRead(ctx context.Context, id int64, userId int32) (*domain.NotificationOutput, error) {
+	notification, err := s.repo.MarkNotificationAsReadByID(ctx, repo.MarkNotificationAsReadByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't mark notification as read: %w", err)
+	}
+
+	return domain.ToNotificationOutput(&notification), nil
+}
+
+// Notify puts notification into in-app inbox of user.
+func (s *notificationService) Notify(ctx context.Context, input domain.NotificationInput) error {
+	_, err := s.repo.CreateNotification(ctx, repo.CreateNotificationParams{
+		UserID: input.UserID,
+		TaskID: pgtype.Int4{
+			Int32: int32(input.TaskID),
+			Valid: input.TaskID != 0,
+		},
+		Title: input.Title,
+		Body:  input.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't create notification: %w", err)
+	}
+
+	return nil
+}
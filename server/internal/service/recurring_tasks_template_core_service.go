@@ -3,11 +3,192 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ali-nur31/mile-do/internal/domain"
 	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/teambition/rrule-go"
 )
 
+func (s *recurringTasksTemplateService) createRecurringTasksTemplateInternal(ctx context.Context, qtx repo.Querier, input domain.CreateRecurringTasksTemplateInput) (*domain.RecurringTasksTemplateOutput, error) {
+	template, err := qtx.CreateRecurringTasksTemplate(ctx, repo.CreateRecurringTasksTemplateParams{
+		UserID: input.UserID,
+		GoalID: input.GoalID,
+		Title:  input.Title,
+		ScheduledDatetime: pgtype.Timestamp{
+			Time:  input.ScheduledDatetime,
+			Valid: !input.ScheduledDatetime.IsZero(),
+		},
+		HasTime:                input.HasTime,
+		DurationMinutes:        input.DurationMinutes,
+		RecurrenceRrule:        input.RecurrenceRrule,
+		Priority:               toTaskPriority(input.Priority),
+		Exdates:                toPgDates(input.Exdates),
+		Rdates:                 toPgDates(input.Rdates),
+		RecurrenceMode:         toRecurrenceMode(input.RecurrenceMode),
+		RecurrenceIntervalDays: input.RecurrenceIntervalDays,
+		AssigneeIds:            toAssigneeIDs(input.AssigneeIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create new recurring tasks template: %w", err)
+	}
+
+	if len(input.TagIDs) > 0 {
+		err = s.tagService.SetRecurringTasksTemplateTags(ctx, qtx, int32(template.ID), input.UserID, input.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	outTemplate := domain.ToRecurringTasksTemplateOutput(&template)
+	outTemplate.TagIDs = input.TagIDs
+
+	return outTemplate, nil
+}
+
+func (s *recurringTasksTemplateService) updateRecurringTasksTemplateInternal(ctx context.Context, qtx repo.Querier, dbTemplate domain.RecurringTasksTemplateOutput, updatingTemplate domain.UpdateRecurringTasksTemplateInput) (*domain.RecurringTasksTemplateOutput, error) {
+	exdates := dbTemplate.Exdates
+	if updatingTemplate.Exdates != nil {
+		exdates = updatingTemplate.Exdates
+	}
+
+	rdates := dbTemplate.Rdates
+	if updatingTemplate.Rdates != nil {
+		rdates = updatingTemplate.Rdates
+	}
+
+	assigneeIds := dbTemplate.AssigneeIDs
+	if updatingTemplate.AssigneeIDs != nil {
+		assigneeIds = updatingTemplate.AssigneeIDs
+	}
+
+	template, err := qtx.UpdateRecurringTasksTemplateByID(ctx, repo.UpdateRecurringTasksTemplateByIDParams{
+		ID:     updatingTemplate.ID,
+		UserID: updatingTemplate.UserID,
+		GoalID: updatingTemplate.GoalID,
+		Title:  updatingTemplate.Title,
+		ScheduledDatetime: pgtype.Timestamp{
+			Time:  updatingTemplate.ScheduledDatetime,
+			Valid: !updatingTemplate.ScheduledDatetime.IsZero(),
+		},
+		HasTime:                updatingTemplate.HasTime,
+		DurationMinutes:        updatingTemplate.DurationMinutes,
+		RecurrenceRrule:        updatingTemplate.RecurrenceRrule,
+		Priority:               toTaskPriority(updatingTemplate.Priority),
+		Exdates:                toPgDates(exdates),
+		Rdates:                 toPgDates(rdates),
+		RecurrenceIntervalDays: updatingTemplate.RecurrenceIntervalDays,
+		AssigneeIds:            toAssigneeIDs(assigneeIds),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't update recurring tasks template: %w", err)
+	}
+
+	tagIds := dbTemplate.TagIDs
+	if updatingTemplate.TagIDs != nil {
+		tagIds = updatingTemplate.TagIDs
+		err = s.tagService.SetRecurringTasksTemplateTags(ctx, qtx, int32(template.ID), updatingTemplate.UserID, updatingTemplate.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	outTemplate := domain.ToRecurringTasksTemplateOutput(&template)
+	outTemplate.TagIDs = tagIds
+
+	return outTemplate, nil
+}
+
+// updateOpenTasksByRecurringTasksTemplateInternal carries new template details over to the still open occurrence of a completion-based template,
+// which is never regenerated because the next one only appears on completion.
+func (s *recurringTasksTemplateService) updateOpenTasksByRecurringTasksTemplateInternal(ctx context.Context, qtx repo.Querier, template domain.RecurringTasksTemplateOutput) error {
+	tasks, err := qtx.UpdateUpcomingTasksByRecurringTasksTemplateID(ctx, repo.UpdateUpcomingTasksByRecurringTasksTemplateIDParams{
+		GoalID:  template.GoalID,
+		Title:   template.Title,
+		HasTime: template.HasTime,
+		ScheduledTime: pgtype.Time{
+			Microseconds: convertTimeToMicroseconds(template.ScheduledDatetime),
+			Valid:        template.HasTime,
+		},
+		DurationMinutes: pgtype.Int4{
+			Int32: template.DurationMinutes,
+			Valid: true,
+		},
+		Priority: toTaskPriority(template.Priority),
+		RecurringTemplateID: pgtype.Int4{
+			Int32: int32(template.ID),
+			Valid: true,
+		},
+		UserID: template.UserID,
+		ScheduledAfter: pgtype.Date{
+			Time:  template.LastGeneratedDate,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't update open tasks by recurring tasks template: %w", err)
+	}
+
+	for _, task := range tasks {
+		err = s.tagService.SetTaskTags(ctx, qtx, int32(task.ID), template.UserID, template.TagIDs)
+		if err != nil {
+			return err
+		}
+
+		err = s.reminderService.ScheduleTaskReminders(ctx, qtx, *domain.ToTaskOutput(&task))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *recurringTasksTemplateService) resetLastGeneratedDateInRecurringTasksTemplateInternal(ctx context.Context, qtx repo.Querier, id int64) (time.Time, error) {
+	template, err := qtx.ResetLastGeneratedDateInRecurringTasksTemplateByID(ctx, id)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("couldn't reset last_generated_date in recurring_template: %w", err)
+	}
+
+	return template.LastGeneratedDate.Time, nil
+}
+
+func (s *recurringTasksTemplateService) addExdateToRecurringTasksTemplateInternal(ctx context.Context, qtx repo.Querier, id int64, userId int32, exdate time.Time) error {
+	err := qtx.AddExdateToRecurringTasksTemplateByID(ctx, repo.AddExdateToRecurringTasksTemplateByIDParams{
+		Exdate: pgtype.Date{
+			Time:  exdate,
+			Valid: true,
+		},
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't add exdate to recurring tasks template: %w", err)
+	}
+
+	return nil
+}
+
+func (s *recurringTasksTemplateService) endRecurringTasksTemplateInternal(ctx context.Context, qtx repo.Querier, dbTemplate domain.RecurringTasksTemplateOutput, until time.Time) error {
+	recurrenceRrule, err := withRecurrenceUntil(dbTemplate.RecurrenceRrule, until)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.UpdateRecurrenceRruleInRecurringTasksTemplateByID(ctx, repo.UpdateRecurrenceRruleInRecurringTasksTemplateByIDParams{
+		ID:              dbTemplate.ID,
+		UserID:          dbTemplate.UserID,
+		RecurrenceRrule: recurrenceRrule,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't end recurring tasks template: %w", err)
+	}
+
+	return nil
+}
+
 func (s *recurringTasksTemplateService) listRecurringTasksTemplatesDueForGenerationInternal(ctx context.Context, qtx repo.Querier) ([]domain.RecurringTasksTemplateOutput, error) {
 	recurringTasksTemplates, err := qtx.ListRecurringTasksTemplatesDueForGeneration(ctx)
 	if err != nil {
@@ -27,3 +208,78 @@ func (s *recurringTasksTemplateService) updateLastGeneratedDateInRecurringTasksT
 
 	return nil
 }
+
+func (s *recurringTasksTemplateService) fillRecurringTasksTemplateTagIDsInternal(ctx context.Context, templates []domain.RecurringTasksTemplateOutput) ([]domain.RecurringTasksTemplateOutput, error) {
+	if len(templates) == 0 {
+		return templates, nil
+	}
+
+	templateIds := make([]int32, len(templates))
+	for i, template := range templates {
+		templateIds[i] = int32(template.ID)
+	}
+
+	tagIds, err := s.tagService.ListTagIDsByRecurringTasksTemplateIDs(ctx, nil, templateIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range templates {
+		templates[i].TagIDs = tagIds[int32(templates[i].ID)]
+	}
+
+	return templates, nil
+}
+
+// withRecurrenceUntil rewrites the RRULE line of recurrenceRrule so that the series ends at until, keeping other lines as is.
+func withRecurrenceUntil(recurrenceRrule string, until time.Time) (string, error) {
+	lines := strings.Split(strings.TrimSpace(recurrenceRrule), "\n")
+	for i, line := range lines {
+		rule, isRrule := strings.CutPrefix(line, "RRULE:")
+		if !isRrule {
+			continue
+		}
+
+		option, err := rrule.StrToROption(rule)
+		if err != nil {
+			return "", fmt.Errorf("couldn't parse rrule from template: %w", err)
+		}
+
+		// UNTIL and COUNT are mutually exclusive, and the split always happens before the counted occurrences run out
+		option.Count = 0
+		option.Until = until.UTC()
+
+		lines[i] = "RRULE:" + option.RRuleString()
+
+		return strings.Join(lines, "\n"), nil
+	}
+
+	return "", fmt.Errorf("couldn't find rrule in template: %q", recurrenceRrule)
+}
+
+func toRecurrenceMode(mode string) repo.RecurrenceMode {
+	if mode == "" {
+		return repo.RecurrenceModeCalendar
+	}
+
+	return repo.RecurrenceMode(mode)
+}
+
+func toPgDates(dates []time.Time) []pgtype.Date {
+	output := make([]pgtype.Date, len(dates))
+	for i, date := range dates {
+		output[i] = pgtype.Date{
+			Time:  date,
+			Valid: true,
+		}
+	}
+	return output
+}
+
+// toAssigneeIDs never returns nil, because nil would be stored as NULL in the not null column.
+func toAssigneeIDs(ids []int32) []int32 {
+	if ids == nil {
+		return []int32{}
+	}
+	return ids
+}
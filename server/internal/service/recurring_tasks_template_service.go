@@ -9,17 +9,24 @@ import (
 	repo "github.com/ali-nur31/mile-do/internal/repository/db"
 	"github.com/hibiken/asynq"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type recurringTasksTemplateService struct {
-	repo  repo.Querier
-	asynq *asynq.Client
+	repo            repo.Querier
+	pool            *pgxpool.Pool
+	asynq           *asynq.Client
+	tagService      domain.TagService
+	reminderService domain.ReminderService
 }
 
-func NewRecurringTasksTemplateService(repo repo.Querier, asynq *asynq.Client) domain.RecurringTasksTemplateService {
+func NewRecurringTasksTemplateService(repo repo.Querier, pool *pgxpool.Pool, asynq *asynq.Client, tagService domain.TagService, reminderService domain.ReminderService) domain.RecurringTasksTemplateService {
 	return &recurringTasksTemplateService{
-		repo:  repo,
-		asynq: asynq,
+		repo:            repo,
+		pool:            pool,
+		asynq:           asynq,
+		tagService:      tagService,
+		reminderService: reminderService,
 	}
 }
 
@@ -29,7 +36,7 @@ func (s *recurringTasksTemplateService) ListRecurringTasksTemplates(ctx context.
 		return nil, fmt.Errorf("couldn't get recurring tasks templates: %w", err)
 	}
 
-	return domain.ToRecurringTasksTemplateOutputList(recurringTasksTemplates), nil
+	return s.fillRecurringTasksTemplateTagIDsInternal(ctx, domain.ToRecurringTasksTemplateOutputList(recurringTasksTemplates))
 }
 
 func (s *recurringTasksTemplateService) GetRecurringTasksTemplateByID(ctx context.Context, id int64, userId int32) (*domain.RecurringTasksTemplateOutput, error) {
@@ -41,27 +48,33 @@ func (s *recurringTasksTemplateService) GetRecurringTasksTemplateByID(ctx contex
 		return nil, fmt.Errorf("couldn't get recurring tasks template by id: %w", err)
 	}
 
-	return domain.ToRecurringTasksTemplateOutput(&template), nil
+	templates, err := s.fillRecurringTasksTemplateTagIDsInternal(ctx, []domain.RecurringTasksTemplateOutput{*domain.ToRecurringTasksTemplateOutput(&template)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &templates[0], nil
 }
 
 func (s *recurringTasksTemplateService) CreateRecurringTasksTemplate(ctx context.Context, input domain.CreateRecurringTasksTemplateInput) (*domain.RecurringTasksTemplateOutput, error) {
-	template, err := s.repo.CreateRecurringTasksTemplate(ctx, repo.CreateRecurringTasksTemplateParams{
-		UserID: input.UserID,
-		GoalID: input.GoalID,
-		Title:  input.Title,
-		ScheduledDatetime: pgtype.Timestamp{
-			Time:  input.ScheduledDatetime,
-			Valid: !input.ScheduledDatetime.IsZero(),
-		},
-		HasTime:         input.HasTime,
-		DurationMinutes: input.DurationMinutes,
-		RecurrenceRrule: input.RecurrenceRrule,
-	})
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	outTemplate, err := s.createRecurringTasksTemplateInternal(ctx, qtx, input)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't create new recurring tasks template: %w", err)
+		return nil, err
 	}
 
-	outTemplate := domain.ToRecurringTasksTemplateOutput(&template)
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for creating recurring tasks template: %w", err)
+	}
 
 	_, err = s.asynq.Enqueue(domain.NewGenerateRecurringTasksByTemplateTask(outTemplate), asynq.Queue("critical"))
 	if err != nil {
@@ -71,26 +84,50 @@ func (s *recurringTasksTemplateService) CreateRecurringTasksTemplate(ctx context
 	return outTemplate, nil
 }
 
+// ImportRecurringTasksTemplate creates template inside transaction of importer, which is in charge of generating its tasks.
+func (s *recurringTasksTemplateService) ImportRecurringTasksTemplate(ctx context.Context, qtx repo.Querier, input domain.CreateRecurringTasksTemplateInput) (*domain.RecurringTasksTemplateOutput, error) {
+	return s.createRecurringTasksTemplateInternal(ctx, qtx, input)
+}
+
 func (s *recurringTasksTemplateService) UpdateRecurringTasksTemplateByID(ctx context.Context, dbTemplate domain.RecurringTasksTemplateOutput, updatingTemplate domain.UpdateRecurringTasksTemplateInput) (*domain.RecurringTasksTemplateOutput, error) {
-	templateUpdatingParams := repo.UpdateRecurringTasksTemplateByIDParams{
-		ID:     updatingTemplate.ID,
-		UserID: updatingTemplate.UserID,
-		GoalID: updatingTemplate.GoalID,
-		Title:  updatingTemplate.Title,
-		ScheduledDatetime: pgtype.Timestamp{
-			Time:  updatingTemplate.ScheduledDatetime,
-			Valid: !updatingTemplate.ScheduledDatetime.IsZero(),
-		},
-		HasTime:         updatingTemplate.HasTime,
-		DurationMinutes: updatingTemplate.DurationMinutes,
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	outTemplate, err := s.updateRecurringTasksTemplateInternal(ctx, qtx, dbTemplate, updatingTemplate)
+	if err != nil {
+		return nil, err
 	}
 
-	template, err := s.repo.UpdateRecurringTasksTemplateByID(ctx, templateUpdatingParams)
+	if outTemplate.RecurrenceMode == string(repo.RecurrenceModeAfterCompletion) {
+		err = s.updateOpenTasksByRecurringTasksTemplateInternal(ctx, qtx, *outTemplate)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = tx.Commit(ctx); err != nil {
+			return nil, fmt.Errorf("couldn't commit transaction for updating recurring tasks template: %w", err)
+		}
+
+		return outTemplate, nil
+	}
+
+	// future tasks are regenerated from the user's today, so generation has to restart there as well
+	lastGeneratedDate, err := s.resetLastGeneratedDateInRecurringTasksTemplateInternal(ctx, qtx, outTemplate.ID)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't update recurring tasks template: %w", err)
+		return nil, err
 	}
+	outTemplate.LastGeneratedDate = lastGeneratedDate
 
-	outTemplate := domain.ToRecurringTasksTemplateOutput(&template)
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for updating recurring tasks template: %w", err)
+	}
 
 	_, err = s.asynq.Enqueue(domain.NewDeleteRecurringTasksByTemplateIDTask(dbTemplate.ID), asynq.Queue("critical"))
 	if err != nil {
@@ -137,3 +174,50 @@ func (s *recurringTasksTemplateService) UpdateLastGeneratedDateInRecurringTasksT
 
 	return nil
 }
+
+func (s *recurringTasksTemplateService) UpdateRecurringTasksTemplateDetailsByID(ctx context.Context, qtx repo.Querier, dbTemplate domain.RecurringTasksTemplateOutput, updatingTemplate domain.UpdateRecurringTasksTemplateInput) (*domain.RecurringTasksTemplateOutput, error) {
+	if qtx == nil {
+		return s.updateRecurringTasksTemplateInternal(ctx, s.repo, dbTemplate, updatingTemplate)
+	}
+
+	return s.updateRecurringTasksTemplateInternal(ctx, qtx, dbTemplate, updatingTemplate)
+}
+
+func (s *recurringTasksTemplateService) AddExdateToRecurringTasksTemplateByID(ctx context.Context, qtx repo.Querier, id int64, userId int32, exdate time.Time) error {
+	if qtx == nil {
+		return s.addExdateToRecurringTasksTemplateInternal(ctx, s.repo, id, userId, exdate)
+	}
+
+	return s.addExdateToRecurringTasksTemplateInternal(ctx, qtx, id, userId, exdate)
+}
+
+func (s *recurringTasksTemplateService) EndRecurringTasksTemplateByID(ctx context.Context, qtx repo.Querier, dbTemplate domain.RecurringTasksTemplateOutput, until time.Time) error {
+	if qtx == nil {
+		return s.endRecurringTasksTemplateInternal(ctx, s.repo, dbTemplate, until)
+	}
+
+	return s.endRecurringTasksTemplateInternal(ctx, qtx, dbTemplate, until)
+}
+
+func (s *recurringTasksTemplateService) SplitRecurringTasksTemplateByID(ctx context.Context, qtx repo.Querier, dbTemplate domain.RecurringTasksTemplateOutput, until time.Time, input domain.CreateRecurringTasksTemplateInput) (*domain.RecurringTasksTemplateOutput, error) {
+	if qtx == nil {
+		qtx = s.repo
+	}
+
+	err := s.endRecurringTasksTemplateInternal(ctx, qtx, dbTemplate, until)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.createRecurringTasksTemplateInternal(ctx, qtx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.reminderService.CopyRecurringTasksTemplateReminders(ctx, qtx, int32(dbTemplate.ID), int32(template.ID), dbTemplate.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// listRemindedTasksInternal returns the task of reminder, or upcoming timed tasks of its template.
+// Reminder may belong to a member of goal rather than to owner of the task, so tasks are looked up through membership.
+func (s *reminderService) listRemindedTasksInternal(ctx context.Context, reminder domain.ReminderOutput) ([]domain.TaskOutput, error) {
+	if reminder.TaskID != 0 {
+		task, err := s.repo.GetTaskByID(ctx, repo.GetTaskByIDParams{
+			ID:     int64(reminder.TaskID),
+			UserID: reminder.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get task by id: %w", err)
+		}
+
+		return []domain.TaskOutput{*domain.ToTaskOutput(&task)}, nil
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(reminder.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.repo.ListUpcomingTasksByRecurringTemplateID(ctx, repo.ListUpcomingTasksByRecurringTemplateIDParams{
+		RecurringTemplateID: pgtype.Int4{
+			Int32: reminder.RecurringTemplateID,
+			Valid: true,
+		},
+		UserID: reminder.UserID,
+		ScheduledDate: pgtype.Date{
+			Time:  dateInLocation(time.Now(), loc),
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get upcoming tasks by recurring tasks template id: %w", err)
+	}
+
+	return domain.ToTaskOutputList(tasks), nil
+}
+
+// scheduleReminderInternal drops pending job of reminder for task and enqueues a new one, unless reminder time has already passed.
+// Job id is derived from task and reminder, so a later call can find the job and cancel it.
+func (s *reminderService) scheduleReminderInternal(ctx context.Context, reminder domain.ReminderOutput, task domain.TaskOutput) error {
+	s.cancelReminderJobInternal(task.ID, reminder.ID)
+
+	if task.IsDone || !task.HasTime || task.ScheduledDate.IsZero() {
+		return nil
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(task.UserID))
+	if err != nil {
+		return err
+	}
+
+	remindAt := taskStartsAt(task, loc).Add(-time.Duration(reminder.OffsetMinutes) * time.Minute)
+	if !remindAt.After(time.Now()) {
+		return nil
+	}
+
+	_, err = s.asynq.Enqueue(domain.NewDeliverReminderTask(&domain.ReminderPayload{
+		ReminderID: reminder.ID,
+		TaskID:     task.ID,
+		UserID:     reminder.UserID,
+		RemindAt:   remindAt,
+	}), asynq.Queue("critical"), asynq.ProcessAt(remindAt), asynq.TaskID(reminderJobID(task.ID, reminder.ID)))
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return fmt.Errorf("couldn't enqueue reminder: %w", err)
+	}
+
+	return nil
+}
+
+// cancelReminderJobInternal is best effort, a job that can't be removed is skipped by DeliverReminder once it went stale.
+func (s *reminderService) cancelReminderJobInternal(taskId int64, reminderId int64) {
+	err := s.inspector.DeleteTask("critical", reminderJobID(taskId, reminderId))
+	if err != nil && !errors.Is(err, asynq.ErrTaskNotFound) && !errors.Is(err, asynq.ErrQueueNotFound) {
+		slog.Error("couldn't cancel reminder", "task_id", taskId, "reminder_id", reminderId, "error", err)
+	}
+}
+
+func reminderJobID(taskId int64, reminderId int64) string {
+	return fmt.Sprintf("reminder:%d:%d", taskId, reminderId)
+}
+
+func reminderAppliesToTask(reminder domain.ReminderOutput, task domain.TaskOutput) bool {
+	if reminder.TaskID != 0 {
+		return int64(reminder.TaskID) == task.ID
+	}
+
+	return reminder.RecurringTemplateID == task.RecurringTemplateID
+}
+
+// taskStartsAt returns the moment task starts, its date and time are wall clock of user's time zone.
+func taskStartsAt(task domain.TaskOutput, loc *time.Location) time.Time {
+	return wallClockInLocation(combineDateAndTime(task.ScheduledDate, task.ScheduledTime), loc)
+}
+
+func reminderBody(offsetMinutes int32, startsAt time.Time) string {
+	clock := startsAt.Format("15:04")
+
+	switch {
+	case offsetMinutes == 0:
+		return fmt.Sprintf("Starts now, at %s", clock)
+	case offsetMinutes%(24*60) == 0:
+		return fmt.Sprintf("Starts in %d day(s), at %s", offsetMinutes/(24*60), clock)
+	case offsetMinutes%60 == 0:
+		return fmt.Sprintf("Starts in %d hour(s), at %s", offsetMinutes/60, clock)
+	default:
+		return fmt.Sprintf("Starts in %d minute(s), at %s", offsetMinutes, clock)
+	}
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type reminderService struct {
+	repo        repo.Querier
+	asynq       *asynq.Client
+	inspector   *asynq.Inspector
+	userService domain.UserService
+	notifier    domain.Notifier
+}
+
+func NewReminderService(repo repo.Querier, asynq *asynq.Client, inspector *asynq.Inspector, userService domain.UserService, notifier domain.Notifier) domain.ReminderService {
+	return &reminderService{
+		repo:        repo,
+		asynq:       asynq,
+		inspector:   inspector,
+		userService: userService,
+		notifier:    notifier,
+	}
+}
+
+func (s *reminderService) ListTaskReminders(ctx context.Context, taskId int32, userId int32) ([]domain.ReminderOutput, error) {
+	reminders, err := s.repo.ListRemindersByTaskID(ctx, repo.ListRemindersByTaskIDParams{
+		TaskID: pgtype.Int4{
+			Int32: taskId,
+			Valid: true,
+		},
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get reminders by task id: %w", err)
+	}
+
+	return domain.ToReminderOutputList(reminders), nil
+}
+
+func (s *reminderService) ListRecurringTasksTemplateReminders(ctx context.Context, templateId int32, userId int32) ([]domain.ReminderOutput, error) {
+	reminders, err := s.repo.ListRemindersByRecurringTemplateID(ctx, repo.ListRemindersByRecurringTemplateIDParams{
+		RecurringTemplateID: pgtype.Int4{
+			Int32: templateId,
+			Valid: true,
+		},
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get reminders by recurring tasks template id: %w", err)
+	}
+
+	return domain.ToReminderOutputList(reminders), nil
+}
+
+func (s *reminderService) GetReminderByID(ctx context.Context, id int64, userId int32) (*domain.ReminderOutput, error) {
+	reminder, err := s.repo.GetReminderByID(ctx, repo.GetReminderByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get reminder by id: %w", err)
+	}
+
+	return domain.ToReminderOutput(&reminder), nil
+}
+
+// CreateReminder adds reminder to either task or recurring tasks template and schedules it for every upcoming task it applies to.
+func (s *reminderService) CreateReminder(ctx context.Context, input domain.CreateReminderInput) (*domain.ReminderOutput, error) {
+	reminder, err := s.repo.CreateReminder(ctx, repo.CreateReminderParams{
+		UserID: input.UserID,
+		TaskID: pgtype.Int4{
+			Int32: input.TaskID,
+			Valid: input.TaskID != 0,
+		},
+		RecurringTemplateID: pgtype.Int4{
+			Int32: input.RecurringTemplateID,
+			Valid: input.RecurringTemplateID != 0,
+		},
+		OffsetMinutes: input.OffsetMinutes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create reminder: %w", err)
+	}
+
+	outReminder := domain.ToReminderOutput(&reminder)
+
+	tasks, err := s.listRemindedTasksInternal(ctx, *outReminder)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range tasks {
+		err = s.scheduleReminderInternal(ctx, *outReminder, task)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return outReminder, nil
+}
+
+func (s *reminderService) DeleteReminderByID(ctx context.Context, id int64, userId int32) error {
+	reminder, err := s.GetReminderByID(ctx, id, userId)
+	if err != nil {
+		return err
+	}
+
+	tasks, err := s.listRemindedTasksInternal(ctx, *reminder)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteReminderByID(ctx, repo.DeleteReminderByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete reminder by id: %w", err)
+	}
+
+	for _, task := range tasks {
+		s.cancelReminderJobInternal(task.ID, reminder.ID)
+	}
+
+	return nil
+}
+
+// CopyRecurringTasksTemplateReminders carries reminders over to the template a series is split into.
+func (s *reminderService) CopyRecurringTasksTemplateReminders(ctx context.Context, qtx repo.Querier, fromTemplateId int32, toTemplateId int32, userId int32) error {
+	if qtx == nil {
+		qtx = s.repo
+	}
+
+	reminders, err := qtx.ListRemindersByRecurringTemplateID(ctx, repo.ListRemindersByRecurringTemplateIDParams{
+		RecurringTemplateID: pgtype.Int4{
+			Int32: fromTemplateId,
+			Valid: true,
+		},
+		UserID: userId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't get reminders by recurring tasks template id: %w", err)
+	}
+
+	for _, reminder := range reminders {
+		_, err = qtx.CreateReminder(ctx, repo.CreateReminderParams{
+			UserID: userId,
+			RecurringTemplateID: pgtype.Int4{
+				Int32: toTemplateId,
+				Valid: true,
+			},
+			OffsetMinutes: reminder.OffsetMinutes,
+		})
+		if err != nil {
+			return fmt.Errorf("couldn't copy reminder: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ScheduleTaskReminders replaces pending reminder jobs of task with ones matching its current date and time.
+func (s *reminderService) ScheduleTaskReminders(ctx context.Context, qtx repo.Querier, task domain.TaskOutput) error {
+	if qtx == nil {
+		qtx = s.repo
+	}
+
+	reminders, err := qtx.ListEffectiveRemindersByTaskID(ctx, repo.ListEffectiveRemindersByTaskIDParams{
+		ID:     task.ID,
+		UserID: task.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't get reminders of task: %w", err)
+	}
+
+	for _, reminder := range reminders {
+		err = s.scheduleReminderInternal(ctx, *domain.ToReminderOutput(&reminder), task)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *reminderService) CancelTaskReminders(ctx context.Context, taskId int64, userId int32) error {
+	reminders, err := s.repo.ListEffectiveRemindersByTaskID(ctx, repo.ListEffectiveRemindersByTaskIDParams{
+		ID:     taskId,
+		UserID: userId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't get reminders of task: %w", err)
+	}
+
+	for _, reminder := range reminders {
+		s.cancelReminderJobInternal(taskId, reminder.ID)
+	}
+
+	return nil
+}
+
+// DeliverReminder notifies user who set reminder unless it went stale since it was scheduled: task or reminder is gone,
+// user lost access to task, task is done, or task moved to a different time, which has a job of its own.
+func (s *reminderService) DeliverReminder(ctx context.Context, payload domain.ReminderPayload) error {
+	reminder, err := s.repo.GetReminderByID(ctx, repo.GetReminderByIDParams{
+		ID:     payload.ReminderID,
+		UserID: payload.UserID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get reminder by id: %w", err)
+	}
+
+	dbTask, err := s.repo.GetTaskByID(ctx, repo.GetTaskByIDParams{
+		ID:     payload.TaskID,
+		UserID: payload.UserID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get task by id: %w", err)
+	}
+
+	task := domain.ToTaskOutput(&dbTask)
+	if !reminderAppliesToTask(*domain.ToReminderOutput(&reminder), *task) || task.IsDone || !task.HasTime {
+		return nil
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(task.UserID))
+	if err != nil {
+		return err
+	}
+
+	startsAt := taskStartsAt(*task, loc)
+	if !startsAt.Add(-time.Duration(reminder.OffsetMinutes) * time.Minute).Equal(payload.RemindAt) {
+		slog.Info("skipping stale reminder", "reminder_id", reminder.ID, "task_id", task.ID)
+		return nil
+	}
+
+	return s.notifier.Notify(ctx, domain.NotificationInput{
+		UserID: reminder.UserID,
+		TaskID: task.ID,
+		Title:  task.Title,
+		Body:   reminderBody(reminder.OffsetMinutes, startsAt),
+	})
+}
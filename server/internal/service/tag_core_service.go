@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+// validateTagIDsInternal returns InvalidTagError unless every tag belongs to user, so tags are never replaced partially.
+func (s *tagService) validateTagIDsInternal(ctx context.Context, qtx repo.Querier, userId int32, tagIds []int32) error {
+	if len(tagIds) == 0 {
+		return nil
+	}
+
+	uniqueTagIds := slices.Compact(slices.Sorted(slices.Values(tagIds)))
+
+	count, err := qtx.CountTagsByIDs(ctx, repo.CountTagsByIDsParams{
+		UserID: userId,
+		TagIds: uniqueTagIds,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't count tags by ids: %w", err)
+	}
+
+	if int(count) != len(uniqueTagIds) {
+		return domain.InvalidTagError
+	}
+
+	return nil
+}
+
+func (s *tagService) setTaskTagsInternal(ctx context.Context, qtx repo.Querier, taskId int32, userId int32, tagIds []int32) error {
+	err := s.validateTagIDsInternal(ctx, qtx, userId, tagIds)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteTaskTagsByTaskID(ctx, taskId)
+	if err != nil {
+		return fmt.Errorf("couldn't delete task tags: %w", err)
+	}
+
+	if len(tagIds) == 0 {
+		return nil
+	}
+
+	err = qtx.CreateTaskTags(ctx, repo.CreateTaskTagsParams{
+		TaskID: taskId,
+		UserID: userId,
+		TagIds: tagIds,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't create task tags: %w", err)
+	}
+
+	return nil
+}
+
+func (s *tagService) listTagIDsByRecurringTasksTemplateIDsInternal(ctx context.Context, qtx repo.Querier, templateIds []int32) (map[int32][]int32, error) {
+	tagIds := make(map[int32][]int32, len(templateIds))
+	if len(templateIds) == 0 {
+		return tagIds, nil
+	}
+
+	rows, err := qtx.ListTagIDsByRecurringTasksTemplateIDs(ctx, templateIds)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tag ids by recurring tasks template ids: %w", err)
+	}
+
+	for _, row := range rows {
+		tagIds[row.TemplateID] = append(tagIds[row.TemplateID], row.TagID)
+	}
+
+	return tagIds, nil
+}
+
+func (s *tagService) setRecurringTasksTemplateTagsInternal(ctx context.Context, qtx repo.Querier, templateId int32, userId int32, tagIds []int32) error {
+	err := s.validateTagIDsInternal(ctx, qtx, userId, tagIds)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteRecurringTasksTemplateTagsByTemplateID(ctx, templateId)
+	if err != nil {
+		return fmt.Errorf("couldn't delete recurring tasks template tags: %w", err)
+	}
+
+	if len(tagIds) == 0 {
+		return nil
+	}
+
+	err = qtx.CreateRecurringTasksTemplateTags(ctx, repo.CreateRecurringTasksTemplateTagsParams{
+		TemplateID: templateId,
+		UserID:     userId,
+		TagIds:     tagIds,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't create recurring tasks template tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type tagService struct {
+	repo repo.Querier
+}
+
+func NewTagService(repo repo.Querier) domain.TagService {
+	return &tagService{
+		repo: repo,
+	}
+}
+
+func (s *tagService) ListTags(ctx context.Context, userId int32) ([]domain.TagOutput, error) {
+	tags, err := s.repo.ListTags(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tags: %w", err)
+	}
+
+	return domain.ToTagOutputList(tags), nil
+}
+
+func (s *tagService) GetTagByID(ctx context.Context, id int64, userId int32) (*domain.TagOutput, error) {
+	tag, err := s.repo.GetTagByID(ctx, repo.GetTagByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tag by id: %w", err)
+	}
+
+	return domain.ToTagOutput(&tag), nil
+}
+
+func (s *tagService) CreateTag(ctx context.Context, input domain.CreateTagInput) (*domain.TagOutput, error) {
+	tag, err := s.repo.CreateTag(ctx, repo.CreateTagParams{
+		UserID: input.UserID,
+		Title:  input.Title,
+		Color: pgtype.Text{
+			String: input.Color,
+			Valid:  input.Color != "",
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create tag: %w", err)
+	}
+
+	return domain.ToTagOutput(&tag), nil
+}
+
+func (s *tagService) UpdateTag(ctx context.Context, input domain.UpdateTagInput) (*domain.TagOutput, error) {
+	tag, err := s.repo.UpdateTagByID(ctx, repo.UpdateTagByIDParams{
+		ID:     input.ID,
+		UserID: input.UserID,
+		Title:  input.Title,
+		Color: pgtype.Text{
+			String: input.Color,
+			Valid:  input.Color != "",
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't update tag: %w", err)
+	}
+
+	return domain.ToTagOutput(&tag), nil
+}
+
+func (s *tagService) DeleteTagByID(ctx context.Context, id int64, userId int32) error {
+	err := s.repo.DeleteTagByID(ctx, repo.DeleteTagByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete tag by id: %w", err)
+	}
+
+	return nil
+}
+
+func (s *tagService) ListTagsByTaskIDs(ctx context.Context, taskIds []int32) (map[int32][]domain.TagOutput, error) {
+	tags := make(map[int32][]domain.TagOutput, len(taskIds))
+	if len(taskIds) == 0 {
+		return tags, nil
+	}
+
+	rows, err := s.repo.ListTagsByTaskIDs(ctx, taskIds)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tags by task ids: %w", err)
+	}
+
+	for _, row := range rows {
+		tags[row.TaskID] = append(tags[row.TaskID], domain.TagOutput{
+			ID:    row.ID,
+			Title: row.Title,
+			Color: row.Color.String,
+		})
+	}
+
+	return tags, nil
+}
+
+func (s *tagService) SetTaskTags(ctx context.Context, qtx repo.Querier, taskId int32, userId int32, tagIds []int32) error {
+	if qtx == nil {
+		return s.setTaskTagsInternal(ctx, s.repo, taskId, userId, tagIds)
+	}
+
+	return s.setTaskTagsInternal(ctx, qtx, taskId, userId, tagIds)
+}
+
+func (s *tagService) ListTagIDsByRecurringTasksTemplateIDs(ctx context.Context, qtx repo.Querier, templateIds []int32) (map[int32][]int32, error) {
+	if qtx == nil {
+		return s.listTagIDsByRecurringTasksTemplateIDsInternal(ctx, s.repo, templateIds)
+	}
+
+	return s.listTagIDsByRecurringTasksTemplateIDsInternal(ctx, qtx, templateIds)
+}
+
+func (s *tagService) SetRecurringTasksTemplateTags(ctx context.Context, qtx repo.Querier, templateId int32, userId int32, tagIds []int32) error {
+	if qtx == nil {
+		return s.setRecurringTasksTemplateTagsInternal(ctx, s.repo, templateId, userId, tagIds)
+	}
+
+	return s.setRecurringTasksTemplateTagsInternal(ctx, qtx, templateId, userId, tagIds)
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5"
+)
+
+const deletedBlobsBatchSize = 100
+
+type taskAttachmentService struct {
+	repo         repo.Querier
+	store        domain.BlobStore
+	maxSizeBytes int64
+	maxPerTask   int
+}
+
+func NewTaskAttachmentService(repo repo.Querier, store domain.BlobStore, maxSizeBytes int64, maxPerTask int) domain.TaskAttachmentService {
+	return &taskAttachmentService{
+		repo:         repo,
+		store:        store,
+		maxSizeBytes: maxSizeBytes,
+		maxPerTask:   maxPerTask,
+	}
+}
+
+// ListTaskAttachments returns attachments of task oldest first, access to task is checked by caller.
+func (s *taskAttachmentService) ListTaskAttachments(ctx context.Context, taskId int32) ([]domain.TaskAttachmentOutput, error) {
+	attachments, err := s.repo.ListTaskAttachmentsByTaskID(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get task attachments: %w", err)
+	}
+
+	return domain.ToTaskAttachmentOutputList(attachments), nil
+}
+
+// CreateTaskAttachment stores content in blob store first and removes it again when the row couldn't be saved.
+func (s *taskAttachmentService) CreateTaskAttachment(ctx context.Context, input domain.CreateTaskAttachmentInput) (*domain.TaskAttachmentOutput, error) {
+	if input.Size > s.maxSizeBytes {
+		return nil, domain.TaskAttachmentTooLargeError
+	}
+
+	count, err := s.repo.CountTaskAttachmentsByTaskID(ctx, input.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't count task attachments: %w", err)
+	}
+
+	if count >= int64(s.maxPerTask) {
+		return nil, domain.TaskAttachmentLimitError
+	}
+
+	storageKey, err := generateAttachmentStorageKey(input.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.Put(ctx, storageKey, io.LimitReader(input.Content, input.Size), input.Size, input.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.repo.CreateTaskAttachment(ctx, repo.CreateTaskAttachmentParams{
+		TaskID:      input.TaskID,
+		UserID:      input.UserID,
+		FileName:    input.FileName,
+		ContentType: input.ContentType,
+		SizeBytes:   input.Size,
+		StorageKey:  storageKey,
+	})
+	if err != nil {
+		if deleteErr := s.store.Delete(ctx, storageKey); deleteErr != nil {
+			slog.Error("couldn't remove blob of unsaved attachment", "storage_key", storageKey, "error", deleteErr)
+		}
+		return nil, fmt.Errorf("couldn't create task attachment: %w", err)
+	}
+
+	return domain.ToTaskAttachmentOutput(&attachment), nil
+}
+
+// OpenTaskAttachment returns attachment with its content, caller closes the content.
+func (s *taskAttachmentService) OpenTaskAttachment(ctx context.Context, id int64, taskId int32) (*domain.TaskAttachmentOutput, io.ReadCloser, error) {
+	attachment, err := s.repo.GetTaskAttachmentByID(ctx, repo.GetTaskAttachmentByIDParams{
+		ID:     id,
+		TaskID: taskId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, domain.TaskAttachmentNotFoundError
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't get task attachment by id: %w", err)
+	}
+
+	content, err := s.store.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, domain.BlobNotFoundError) {
+		return nil, nil, domain.TaskAttachmentNotFoundError
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return domain.ToTaskAttachmentOutput(&attachment), content, nil
+}
+
+// DeleteTaskAttachmentByID removes attachment row, its blob is queued for PurgeDeletedBlobs by trigger.
+func (s *taskAttachmentService) DeleteTaskAttachmentByID(ctx context.Context, id int64, taskId int32) error {
+	removed, err := s.repo.DeleteTaskAttachmentByID(ctx, repo.DeleteTaskAttachmentByIDParams{
+		ID:     id,
+		TaskID: taskId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete task attachment by id: %w", err)
+	}
+
+	if removed == 0 {
+		return domain.TaskAttachmentNotFoundError
+	}
+
+	return nil
+}
+
+// PurgeDeletedBlobs removes blobs of deleted attachments from blob store in batches,
+// keys stay queued when removal fails, so the next run retries them.
+func (s *taskAttachmentService) PurgeDeletedBlobs(ctx context.Context) (int64, error) {
+	var purged int64
+
+	for {
+		blobs, err := s.repo.ListDeletedBlobs(ctx, deletedBlobsBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("couldn't get deleted blobs: %w", err)
+		}
+
+		for _, blob := range blobs {
+			if err = s.store.Delete(ctx, blob.StorageKey); err != nil {
+				return purged, err
+			}
+
+			if err = s.repo.DeleteDeletedBlob(ctx, blob.StorageKey); err != nil {
+				return purged, fmt.Errorf("couldn't dequeue deleted blob: %w", err)
+			}
+
+			purged++
+		}
+
+		if len(blobs) < deletedBlobsBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+func generateAttachmentStorageKey(taskId int32) (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("couldn't generate attachment storage key: %w", err)
+	}
+
+	return fmt.Sprintf("tasks/%d/%s", taskId, hex.EncodeToString(bytes)), nil
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+func (s *taskChecklistItemService) completeTaskChecklistItemsByTaskIDInternal(ctx context.Context, qtx repo.Querier, taskId int32) error {
+	err := qtx.UpdateIsDoneInTaskChecklistItemsByTaskID(ctx, repo.UpdateIsDoneInTaskChecklistItemsByTaskIDParams{
+		TaskID: taskId,
+		IsDone: true,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't complete task checklist items: %w", err)
+	}
+
+	return nil
+}
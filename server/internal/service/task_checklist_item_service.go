@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+type taskChecklistItemService struct {
+	repo repo.Querier
+}
+
+func NewTaskChecklistItemService(repo repo.Querier) domain.TaskChecklistItemService {
+	return &taskChecklistItemService{
+		repo: repo,
+	}
+}
+
+func (s *taskChecklistItemService) ListTaskChecklistItems(ctx context.Context, taskId int32) ([]domain.TaskChecklistItemOutput, error) {
+	items, err := s.repo.ListTaskChecklistItemsByTaskID(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get task checklist items: %w", err)
+	}
+
+	return domain.ToTaskChecklistItemOutputList(items), nil
+}
+
+func (s *taskChecklistItemService) GetTaskChecklistItemByID(ctx context.Context, id int64, taskId int32) (*domain.TaskChecklistItemOutput, error) {
+	item, err := s.repo.GetTaskChecklistItemByID(ctx, repo.GetTaskChecklistItemByIDParams{
+		ID:     id,
+		TaskID: taskId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get task checklist item by id: %w", err)
+	}
+
+	return domain.ToTaskChecklistItemOutput(&item), nil
+}
+
+func (s *taskChecklistItemService) CreateTaskChecklistItem(ctx context.Context, input domain.CreateTaskChecklistItemInput) (*domain.TaskChecklistItemOutput, error) {
+	item, err := s.repo.CreateTaskChecklistItem(ctx, repo.CreateTaskChecklistItemParams{
+		TaskID: input.TaskID,
+		UserID: input.UserID,
+		Title:  input.Title,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create task checklist item: %w", err)
+	}
+
+	return domain.ToTaskChecklistItemOutput(&item), nil
+}
+
+func (s *taskChecklistItemService) UpdateTaskChecklistItem(ctx context.Context, input domain.UpdateTaskChecklistItemInput) (*domain.TaskChecklistItemOutput, error) {
+	item, err := s.repo.UpdateTaskChecklistItemByID(ctx, repo.UpdateTaskChecklistItemByIDParams{
+		ID:       input.ID,
+		TaskID:   input.TaskID,
+		Title:    input.Title,
+		IsDone:   input.IsDone,
+		Position: input.Position,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't update task checklist item: %w", err)
+	}
+
+	return domain.ToTaskChecklistItemOutput(&item), nil
+}
+
+func (s *taskChecklistItemService) DeleteTaskChecklistItemByID(ctx context.Context, id int64, taskId int32) error {
+	err := s.repo.DeleteTaskChecklistItemByID(ctx, repo.DeleteTaskChecklistItemByIDParams{
+		ID:     id,
+		TaskID: taskId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete task checklist item by id: %w", err)
+	}
+
+	return nil
+}
+
+func (s *taskChecklistItemService) CompleteTaskChecklistItemsByTaskID(ctx context.Context, qtx repo.Querier, taskId int32) error {
+	if qtx == nil {
+		return s.completeTaskChecklistItemsByTaskIDInternal(ctx, s.repo, taskId)
+	}
+
+	return s.completeTaskChecklistItemsByTaskIDInternal(ctx, qtx, taskId)
+}
+
+func (s *taskChecklistItemService) GetTaskChecklistProgressByTaskIDs(ctx context.Context, taskIds []int32) (map[int32]domain.TaskChecklistProgressOutput, error) {
+	progress := make(map[int32]domain.TaskChecklistProgressOutput, len(taskIds))
+	if len(taskIds) == 0 {
+		return progress, nil
+	}
+
+	rows, err := s.repo.CountTaskChecklistItemsByTaskIDs(ctx, taskIds)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't count task checklist items: %w", err)
+	}
+
+	for _, row := range rows {
+		progress[row.TaskID] = domain.TaskChecklistProgressOutput{
+			Done:  row.Done,
+			Total: row.Total,
+		}
+	}
+
+	return progress, nil
+}
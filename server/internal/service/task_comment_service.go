@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type taskCommentService struct {
+	repo repo.Querier
+}
+
+func NewTaskCommentService(repo repo.Querier) domain.TaskCommentService {
+	return &taskCommentService{
+		repo: repo,
+	}
+}
+
+// ListTaskComments returns comments of task oldest first, access to task is checked by caller.
+func (s *taskCommentService) ListTaskComments(ctx context.Context, taskId int32) ([]domain.TaskCommentOutput, error) {
+	comments, err := s.repo.ListTaskCommentsByTaskID(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get task comments: %w", err)
+	}
+
+	return domain.ToTaskCommentOutputList(comments), nil
+}
+
+func (s *taskCommentService) CreateTaskComment(ctx context.Context, input domain.CreateTaskCommentInput) (*domain.TaskCommentOutput, error) {
+	comment, err := s.repo.CreateTaskComment(ctx, repo.CreateTaskCommentParams{
+		TaskID: input.TaskID,
+		UserID: input.UserID,
+		Body:   input.Body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create task comment: %w", err)
+	}
+
+	return domain.ToTaskCommentOutput(&comment), nil
+}
+
+// UpdateTaskComment changes body of comment, only its author can do it.
+func (s *taskCommentService) UpdateTaskComment(ctx context.Context, input domain.UpdateTaskCommentInput) (*domain.TaskCommentOutput, error) {
+	comment, err := s.repo.UpdateTaskCommentByID(ctx, repo.UpdateTaskCommentByIDParams{
+		ID:     input.ID,
+		TaskID: input.TaskID,
+		UserID: input.UserID,
+		Body:   input.Body,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.TaskCommentNotFoundError
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't update task comment: %w", err)
+	}
+
+	return domain.ToTaskCommentOutput(&comment), nil
+}
+
+// DeleteTaskCommentByID removes comment, only its author can do it.
+func (s *taskCommentService) DeleteTaskCommentByID(ctx context.Context, id int64, taskId int32, userId int32) error {
+	removed, err := s.repo.DeleteTaskCommentByID(ctx, repo.DeleteTaskCommentByIDParams{
+		ID:     id,
+		TaskID: taskId,
+		UserID: userId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete task comment by id: %w", err)
+	}
+
+	if removed == 0 {
+		return domain.TaskCommentNotFoundError
+	}
+
+	return nil
+}
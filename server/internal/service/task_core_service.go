@@ -2,19 +2,34 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/ali-nur31/mile-do/internal/domain"
 	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/teambition/rrule-go"
 )
 
 func (s *taskService) CreateTasksByTemplateInternal(ctx context.Context, template domain.RecurringTasksTemplateOutput, qtx repo.Querier) error {
-	var err error
+	loc, err := s.userService.GetUserLocation(ctx, qtx, int64(template.UserID))
+	if err != nil {
+		return err
+	}
+
+	if template.RecurrenceMode == string(repo.RecurrenceModeAfterCompletion) {
+		// later occurrences are created by CompleteTask, only the first one comes from the template itself
+		if !template.LastGeneratedDate.IsZero() {
+			return nil
+		}
 
-	horizonDate := time.Now().UTC().AddDate(0, 3, 0)
+		return s.createNextTaskByTemplateInternal(ctx, qtx, template, dateInLocation(template.ScheduledDatetime, time.UTC))
+	}
+
+	horizonDate := time.Now().In(loc).AddDate(0, 3, 0)
 	var rule *rrule.Set
 
 	rule, err = rrule.StrToRRuleSet(template.RecurrenceRrule)
@@ -22,13 +37,22 @@ func (s *taskService) CreateTasksByTemplateInternal(ctx context.Context, templat
 		return fmt.Errorf("couldn't parse rrule from template: %w", err)
 	}
 
-	rule.DTStart(template.ScheduledDatetime)
+	startDatetime := wallClockInLocation(template.ScheduledDatetime, loc)
+	rule.DTStart(startDatetime)
+
+	for _, exdate := range template.Exdates {
+		rule.ExDate(occurrenceOnDate(exdate, startDatetime))
+	}
+	for _, rdate := range template.Rdates {
+		rule.RDate(occurrenceOnDate(rdate, startDatetime))
+	}
 
 	var lastDate time.Time
 	if template.LastGeneratedDate.IsZero() {
-		lastDate = template.ScheduledDatetime.Add(-1 * time.Second)
+		lastDate = startDatetime.Add(-1 * time.Second)
 	} else {
-		lastDate = template.LastGeneratedDate
+		lastGenerated := template.LastGeneratedDate
+		lastDate = time.Date(lastGenerated.Year(), lastGenerated.Month(), lastGenerated.Day()+1, 0, 0, 0, 0, loc).Add(-1 * time.Second)
 	}
 
 	dates := rule.Between(lastDate, horizonDate, true)
@@ -36,37 +60,22 @@ func (s *taskService) CreateTasksByTemplateInternal(ctx context.Context, templat
 		return nil
 	}
 
+	templateTagIds, err := s.tagService.ListTagIDsByRecurringTasksTemplateIDs(ctx, qtx, []int32{int32(template.ID)})
+	if err != nil {
+		return err
+	}
+	tagIds := templateTagIds[int32(template.ID)]
+
 	for _, date := range dates {
-		scheduledDateOnly := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
-
-		_, err = qtx.CreateTask(ctx, repo.CreateTaskParams{
-			UserID: template.UserID,
-			GoalID: template.GoalID,
-			RecurringTemplateID: pgtype.Int4{
-				Int32: int32(template.ID),
-				Valid: true,
-			},
-			Title: template.Title,
-			ScheduledDate: pgtype.Date{
-				Time:  scheduledDateOnly,
-				Valid: true,
-			},
-			ScheduledTime: pgtype.Time{
-				Microseconds: convertTimeToMicroseconds(date),
-				Valid:        template.HasTime,
-			},
-			HasTime: template.HasTime,
-			DurationMinutes: pgtype.Int4{
-				Int32: template.DurationMinutes,
-				Valid: true,
-			},
-		})
+		date = date.In(loc)
+
+		_, err = s.createTaskByTemplateInternal(ctx, qtx, template, dateInLocation(date, loc), date, tagIds)
 		if err != nil {
-			return fmt.Errorf("couldn't create task by recurring tasks template: %w", err)
+			return err
 		}
 	}
 
-	newLastGeneratedDate := dates[len(dates)-1]
+	newLastGeneratedDate := dateInLocation(dates[len(dates)-1], loc)
 
 	err = s.recurringTasksTemplateService.UpdateLastGeneratedDateInRecurringTasksTemplateByID(ctx, qtx, domain.UpdateLastGeneratedDateInRecurringTasksTemplateInput{
 		ID:                template.ID,
@@ -79,9 +88,663 @@ func (s *taskService) CreateTasksByTemplateInternal(ctx context.Context, templat
 	return nil
 }
 
+// createNextTaskByTemplateInternal creates the single open occurrence of a completion-based template on date,
+// unless the previous one is still waiting to be completed.
+func (s *taskService) createNextTaskByTemplateInternal(ctx context.Context, qtx repo.Querier, template domain.RecurringTasksTemplateOutput, date time.Time) error {
+	openTasks, err := qtx.CountOpenTasksByRecurringTasksTemplateID(ctx, pgtype.Int4{
+		Int32: int32(template.ID),
+		Valid: true,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't count open tasks by recurring tasks template id: %w", err)
+	}
+
+	if openTasks > 0 {
+		return nil
+	}
+
+	templateTagIds, err := s.tagService.ListTagIDsByRecurringTasksTemplateIDs(ctx, qtx, []int32{int32(template.ID)})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.createTaskByTemplateInternal(ctx, qtx, template, date, template.ScheduledDatetime, templateTagIds[int32(template.ID)])
+	if err != nil {
+		return err
+	}
+
+	return s.recurringTasksTemplateService.UpdateLastGeneratedDateInRecurringTasksTemplateByID(ctx, qtx, domain.UpdateLastGeneratedDateInRecurringTasksTemplateInput{
+		ID:                template.ID,
+		LastGeneratedDate: date,
+	})
+}
+
+// finishTaskCompletionInternal records completion of task and creates the next occurrence of its completion-based template.
+func (s *taskService) finishTaskCompletionInternal(ctx context.Context, qtx repo.Querier, task repo.Task, userId int32) error {
+	err := s.recordTaskActivityInternal(ctx, qtx, task.ID, domain.TaskActivityCompleted, nil)
+	if err != nil {
+		return err
+	}
+
+	if !task.RecurringTemplateID.Valid {
+		return nil
+	}
+
+	return s.createNextTaskAfterCompletionInternal(ctx, qtx, task.RecurringTemplateID.Int32, userId)
+}
+
+// createNextTaskAfterCompletionInternal schedules the next occurrence of a completion-based template relative to the user's today.
+// Tasks of calendar-based templates are left alone.
+func (s *taskService) createNextTaskAfterCompletionInternal(ctx context.Context, qtx repo.Querier, templateId int32, userId int32) error {
+	template, err := s.recurringTasksTemplateService.GetRecurringTasksTemplateByID(ctx, int64(templateId), userId)
+	if err != nil {
+		return err
+	}
+
+	if template.RecurrenceMode != string(repo.RecurrenceModeAfterCompletion) {
+		return nil
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, qtx, int64(userId))
+	if err != nil {
+		return err
+	}
+
+	nextDate := dateInLocation(time.Now(), loc).AddDate(0, 0, int(template.RecurrenceIntervalDays))
+
+	return s.createNextTaskByTemplateInternal(ctx, qtx, *template, nextDate)
+}
+
+func (s *taskService) createTaskInternal(ctx context.Context, qtx repo.Querier, input domain.CreateTaskInput) (*domain.TaskOutput, error) {
+	task, err := qtx.CreateTask(ctx, repo.CreateTaskParams{
+		UserID:      input.UserID,
+		GoalID:      input.GoalID,
+		Title:       input.Title,
+		Description: input.Description,
+		ScheduledDate: pgtype.Date{
+			Time:  input.ScheduledDate,
+			Valid: !input.ScheduledDate.IsZero(),
+		},
+		HasTime: input.HasTime,
+		ScheduledTime: pgtype.Time{
+			Microseconds: convertTimeToMicroseconds(input.ScheduledTime),
+			Valid:        input.HasTime,
+		},
+		DurationMinutes: pgtype.Int4{
+			Int32: input.DurationMinutes,
+			Valid: true,
+		},
+		Priority: toTaskPriority(input.Priority),
+		AssigneeID: pgtype.Int4{
+			Int32: input.AssigneeID,
+			Valid: input.AssigneeID != 0,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create task: %w", err)
+	}
+
+	err = s.recordTaskActivityInternal(ctx, qtx, task.ID, domain.TaskActivityCreated, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(input.TagIDs) > 0 {
+		err = s.tagService.SetTaskTags(ctx, qtx, int32(task.ID), input.UserID, input.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return domain.ToTaskOutput(&task), nil
+}
+
+// deleteNextTaskAfterCompletionInternal removes the open occurrence that completing dbTask created for a completion-based template.
+func (s *taskService) deleteNextTaskAfterCompletionInternal(ctx context.Context, qtx repo.Querier, dbTask domain.TaskOutput) error {
+	template, err := s.recurringTasksTemplateService.GetRecurringTasksTemplateByID(ctx, int64(dbTask.RecurringTemplateID), dbTask.UserID)
+	if err != nil {
+		return err
+	}
+
+	if template.RecurrenceMode != string(repo.RecurrenceModeAfterCompletion) {
+		return nil
+	}
+
+	// dbTask is still done here, so only the occurrences after it are open
+	return s.deleteTasksFromDateByRecurringTasksTemplateIDInternal(ctx, qtx, dbTask.RecurringTemplateID, dbTask.UserID, dbTask.ScheduledDate)
+}
+
+func (s *taskService) createTaskByTemplateInternal(ctx context.Context, qtx repo.Querier, template domain.RecurringTasksTemplateOutput, date time.Time, clock time.Time, tagIds []int32) (*domain.TaskOutput, error) {
+	assigneeId, err := s.nextTemplateAssigneeInternal(ctx, qtx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := qtx.CreateTask(ctx, repo.CreateTaskParams{
+		UserID: template.UserID,
+		GoalID: template.GoalID,
+		RecurringTemplateID: pgtype.Int4{
+			Int32: int32(template.ID),
+			Valid: true,
+		},
+		Title: template.Title,
+		ScheduledDate: pgtype.Date{
+			Time:  date,
+			Valid: true,
+		},
+		ScheduledTime: pgtype.Time{
+			Microseconds: convertTimeToMicroseconds(clock),
+			Valid:        template.HasTime,
+		},
+		HasTime: template.HasTime,
+		DurationMinutes: pgtype.Int4{
+			Int32: template.DurationMinutes,
+			Valid: true,
+		},
+		Priority: toTaskPriority(template.Priority),
+		AssigneeID: pgtype.Int4{
+			Int32: assigneeId,
+			Valid: assigneeId != 0,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create task by recurring tasks template: %w", err)
+	}
+
+	if len(tagIds) > 0 {
+		err = s.tagService.SetTaskTags(ctx, qtx, int32(task.ID), template.UserID, tagIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	outTask := domain.ToTaskOutput(&task)
+
+	err = s.reminderService.ScheduleTaskReminders(ctx, qtx, *outTask)
+	if err != nil {
+		return nil, err
+	}
+
+	return outTask, nil
+}
+
+// nextTemplateAssigneeInternal picks assignee of the next occurrence of template,
+// several assignees take turns in the order they were listed, users who left the goal are skipped.
+func (s *taskService) nextTemplateAssigneeInternal(ctx context.Context, qtx repo.Querier, template domain.RecurringTasksTemplateOutput) (int32, error) {
+	assigneeIds := make([]int32, 0, len(template.AssigneeIDs))
+	for _, assigneeId := range template.AssigneeIDs {
+		if assigneeId != template.UserID {
+			_, err := qtx.GetRoleInGoalMemberByIDs(ctx, repo.GetRoleInGoalMemberByIDsParams{
+				GoalID: template.GoalID,
+				UserID: assigneeId,
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return 0, fmt.Errorf("couldn't get role of assignee in goal: %w", err)
+			}
+		}
+
+		assigneeIds = append(assigneeIds, assigneeId)
+	}
+
+	switch len(assigneeIds) {
+	case 0:
+		return 0, nil
+	case 1:
+		return assigneeIds[0], nil
+	}
+
+	rotation, err := qtx.IncrementAssigneeRotationInRecurringTasksTemplateByID(ctx, template.ID)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't rotate assignee of recurring tasks template: %w", err)
+	}
+
+	return assigneeIds[int(rotation-1)%len(assigneeIds)], nil
+}
+
+// resolveRecurrenceScopeInternal narrows scope to what the task's template supports.
+// A completion-based series has a single open occurrence, so "following" covers the whole series.
+func (s *taskService) resolveRecurrenceScopeInternal(ctx context.Context, dbTask domain.TaskOutput, scope domain.RecurrenceScope) (domain.RecurrenceScope, error) {
+	if scope != domain.RecurrenceScopeFollowing {
+		return scope, nil
+	}
+
+	template, err := s.recurringTasksTemplateService.GetRecurringTasksTemplateByID(ctx, int64(dbTask.RecurringTemplateID), dbTask.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	if template.RecurrenceMode == string(repo.RecurrenceModeAfterCompletion) {
+		return domain.RecurrenceScopeAll, nil
+	}
+
+	return scope, nil
+}
+
+func (s *taskService) splitRecurringTaskSeriesInternal(ctx context.Context, qtx repo.Querier, dbTask domain.TaskOutput, updatingTask domain.UpdateTaskInput) (*domain.RecurringTasksTemplateOutput, error) {
+	template, err := s.recurringTasksTemplateService.GetRecurringTasksTemplateByID(ctx, int64(dbTask.RecurringTemplateID), dbTask.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, qtx, int64(dbTask.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	tagIds := template.TagIDs
+	if updatingTask.TagIDs != nil {
+		tagIds = updatingTask.TagIDs
+	}
+
+	return s.recurringTasksTemplateService.SplitRecurringTasksTemplateByID(ctx, qtx, *template, untilBeforeDate(dbTask.ScheduledDate, loc), domain.CreateRecurringTasksTemplateInput{
+		UserID:                 dbTask.UserID,
+		GoalID:                 updatingTask.GoalID,
+		Title:                  updatingTask.Title,
+		ScheduledDatetime:      combineDateAndTime(updatingTask.ScheduledDate, updatingTask.ScheduledTime),
+		HasTime:                updatingTask.HasTime,
+		DurationMinutes:        updatingTask.DurationMinutes,
+		RecurrenceRrule:        template.RecurrenceRrule,
+		RecurrenceMode:         template.RecurrenceMode,
+		RecurrenceIntervalDays: template.RecurrenceIntervalDays,
+		Priority:               updatingTask.Priority,
+		Exdates:                datesFrom(template.Exdates, dbTask.ScheduledDate),
+		Rdates:                 datesFrom(template.Rdates, dbTask.ScheduledDate),
+		AssigneeIDs:            template.AssigneeIDs,
+		TagIDs:                 tagIds,
+	})
+}
+
+func (s *taskService) updateRecurringTaskSeriesInternal(ctx context.Context, qtx repo.Querier, dbTask domain.TaskOutput, updatingTask domain.UpdateTaskInput) error {
+	template, err := s.recurringTasksTemplateService.GetRecurringTasksTemplateByID(ctx, int64(dbTask.RecurringTemplateID), dbTask.UserID)
+	if err != nil {
+		return err
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, qtx, int64(dbTask.UserID))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.recurringTasksTemplateService.UpdateRecurringTasksTemplateDetailsByID(ctx, qtx, *template, domain.UpdateRecurringTasksTemplateInput{
+		ID:                     template.ID,
+		UserID:                 template.UserID,
+		GoalID:                 updatingTask.GoalID,
+		Title:                  updatingTask.Title,
+		ScheduledDatetime:      combineDateAndTime(template.ScheduledDatetime, updatingTask.ScheduledTime),
+		HasTime:                updatingTask.HasTime,
+		DurationMinutes:        updatingTask.DurationMinutes,
+		RecurrenceRrule:        template.RecurrenceRrule,
+		RecurrenceIntervalDays: template.RecurrenceIntervalDays,
+		Priority:               updatingTask.Priority,
+		TagIDs:                 updatingTask.TagIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	tasks, err := qtx.UpdateUpcomingTasksByRecurringTasksTemplateID(ctx, repo.UpdateUpcomingTasksByRecurringTasksTemplateIDParams{
+		GoalID:  updatingTask.GoalID,
+		Title:   updatingTask.Title,
+		HasTime: updatingTask.HasTime,
+		ScheduledTime: pgtype.Time{
+			Microseconds: convertTimeToMicroseconds(updatingTask.ScheduledTime),
+			Valid:        updatingTask.HasTime,
+		},
+		DurationMinutes: pgtype.Int4{
+			Int32: updatingTask.DurationMinutes,
+			Valid: true,
+		},
+		Priority: toTaskPriority(updatingTask.Priority),
+		RecurringTemplateID: pgtype.Int4{
+			Int32: dbTask.RecurringTemplateID,
+			Valid: true,
+		},
+		UserID: dbTask.UserID,
+		ScheduledAfter: pgtype.Date{
+			Time:  dateInLocation(time.Now(), loc),
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't update upcoming tasks by recurring tasks template: %w", err)
+	}
+
+	for _, task := range tasks {
+		if updatingTask.TagIDs != nil {
+			err = s.tagService.SetTaskTags(ctx, qtx, int32(task.ID), dbTask.UserID, updatingTask.TagIDs)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.reminderService.ScheduleTaskReminders(ctx, qtx, *domain.ToTaskOutput(&task))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *taskService) endRecurringTaskSeriesInternal(ctx context.Context, qtx repo.Querier, dbTask domain.TaskOutput) error {
+	template, err := s.recurringTasksTemplateService.GetRecurringTasksTemplateByID(ctx, int64(dbTask.RecurringTemplateID), dbTask.UserID)
+	if err != nil {
+		return err
+	}
+
+	loc, err := s.userService.GetUserLocation(ctx, qtx, int64(dbTask.UserID))
+	if err != nil {
+		return err
+	}
+
+	err = s.recurringTasksTemplateService.EndRecurringTasksTemplateByID(ctx, qtx, *template, untilBeforeDate(dbTask.ScheduledDate, loc))
+	if err != nil {
+		return err
+	}
+
+	return s.deleteTasksFromDateByRecurringTasksTemplateIDInternal(ctx, qtx, dbTask.RecurringTemplateID, dbTask.UserID, dbTask.ScheduledDate)
+}
+
+func (s *taskService) deleteTasksFromDateByRecurringTasksTemplateIDInternal(ctx context.Context, qtx repo.Querier, templateId int32, userId int32, date time.Time) error {
+	err := qtx.DeleteTasksFromDateByRecurringTasksTemplateID(ctx, repo.DeleteTasksFromDateByRecurringTasksTemplateIDParams{
+		RecurringTemplateID: pgtype.Int4{
+			Int32: templateId,
+			Valid: true,
+		},
+		UserID: userId,
+		ScheduledDate: pgtype.Date{
+			Time:  date,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete tasks from date by recurring tasks template id: %w", err)
+	}
+
+	return nil
+}
+
+func (s *taskService) fillTaskDetailsInternal(ctx context.Context, tasks []domain.TaskOutput) ([]domain.TaskOutput, error) {
+	if len(tasks) == 0 {
+		return tasks, nil
+	}
+
+	taskIds := make([]int32, len(tasks))
+	for i, task := range tasks {
+		taskIds[i] = int32(task.ID)
+	}
+
+	progress, err := s.taskChecklistItemService.GetTaskChecklistProgressByTaskIDs(ctx, taskIds)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.tagService.ListTagsByTaskIDs(ctx, taskIds)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedIds, err := s.repo.ListBlockedTaskIDs(ctx, taskIds)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get blocked tasks: %w", err)
+	}
+
+	blocked := make(map[int32]bool, len(blockedIds))
+	for _, id := range blockedIds {
+		blocked[id] = true
+	}
+
+	for i := range tasks {
+		tasks[i].ChecklistProgress = progress[int32(tasks[i].ID)]
+		tasks[i].Tags = tags[int32(tasks[i].ID)]
+		tasks[i].Blocked = blocked[int32(tasks[i].ID)]
+	}
+
+	return tasks, nil
+}
+
+// checkTaskNotBlockedInternal refuses completing task while any of its blockers is unfinished.
+func (s *taskService) checkTaskNotBlockedInternal(ctx context.Context, qtx repo.Querier, taskId int64) error {
+	blockedIds, err := qtx.ListBlockedTaskIDs(ctx, []int32{int32(taskId)})
+	if err != nil {
+		return fmt.Errorf("couldn't check task blockers: %w", err)
+	}
+
+	if len(blockedIds) > 0 {
+		return domain.TaskBlockedError
+	}
+
+	return nil
+}
+
+func (s *taskService) fillTaskDetailsSingleInternal(ctx context.Context, task *domain.TaskOutput) (*domain.TaskOutput, error) {
+	tasks, err := s.fillTaskDetailsInternal(ctx, []domain.TaskOutput{*task})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tasks[0], nil
+}
+
+// fillTaskDetailsAndDispatchInternal fills details of changed task and tells webhooks of user about the change.
+func (s *taskService) fillTaskDetailsAndDispatchInternal(ctx context.Context, task *domain.TaskOutput, event string) (*domain.TaskOutput, error) {
+	outTask, err := s.fillTaskDetailsSingleInternal(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	s.webhookService.DispatchEvent(ctx, outTask.UserID, event, domain.ToWebhookTaskData(outTask))
+
+	return outTask, nil
+}
+
+// dayStreaks finds runs of consecutive days with a completion, times are sorted.
+// The current run may end yesterday, as today isn't over yet.
+func dayStreaks(completedAts []pgtype.Timestamp, loc *time.Location, today time.Time) *domain.StreakOutput {
+	var streak domain.StreakOutput
+	var lastDate time.Time
+	var run int32
+
+	for _, completedAt := range completedAts {
+		date := dateInLocation(completedAt.Time, loc)
+		switch {
+		case date.Equal(lastDate):
+			continue
+		case date.Equal(lastDate.AddDate(0, 0, 1)):
+			run++
+		default:
+			run = 1
+		}
+		lastDate = date
+		streak.LongestStreak = max(streak.LongestStreak, run)
+	}
+
+	if lastDate.Equal(today) || lastDate.Equal(today.AddDate(0, 0, -1)) {
+		streak.CurrentStreak = run
+	}
+
+	return &streak
+}
+
+// occurrenceStreaks finds runs of occurrences completed no later than their day, occurrences are sorted by date.
+// Today's occurrence doesn't break the current run while it's still open.
+func occurrenceStreaks(occurrences []repo.ListOccurrencesByRecurringTasksTemplateIDRow, loc *time.Location, today time.Time) *domain.StreakOutput {
+	var streak domain.StreakOutput
+	var run int32
+
+	for _, occurrence := range occurrences {
+		date := occurrence.ScheduledDate.Time
+		if !occurrence.IsDone && date.Equal(today) {
+			continue
+		}
+
+		// tasks completed before completion times were recorded count as in time
+		inTime := !occurrence.CompletedAt.Valid || !dateInLocation(occurrence.CompletedAt.Time, loc).After(date)
+		if occurrence.IsDone && inTime {
+			run++
+			streak.LongestStreak = max(streak.LongestStreak, run)
+		} else {
+			run = 0
+		}
+	}
+	streak.CurrentStreak = run
+
+	return &streak
+}
+
+// recordTaskActivityInternal appends entry to history of task, changes are nil for actions without field changes.
+func (s *taskService) recordTaskActivityInternal(ctx context.Context, qtx repo.Querier, taskId int64, action string, changes map[string]taskFieldChange) error {
+	if changes == nil {
+		changes = map[string]taskFieldChange{}
+	}
+
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("couldn't encode task activity changes: %w", err)
+	}
+
+	err = qtx.CreateTaskActivity(ctx, repo.CreateTaskActivityParams{
+		TaskID:  int32(taskId),
+		Action:  repo.TaskActivityAction(action),
+		Changes: encoded,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't create task activity: %w", err)
+	}
+
+	return nil
+}
+
+type taskFieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// diffTaskFields returns fields of task that differ between old and new, in the form stored in task activity.
+func diffTaskFields(oldTask domain.TaskOutput, newTask domain.TaskOutput) map[string]taskFieldChange {
+	changes := make(map[string]taskFieldChange)
+	addChange := func(field string, oldValue any, newValue any) {
+		if oldValue != newValue {
+			changes[field] = taskFieldChange{
+				Old: oldValue,
+				New: newValue,
+			}
+		}
+	}
+
+	addChange("goal_id", oldTask.GoalID, newTask.GoalID)
+	addChange("title", oldTask.Title, newTask.Title)
+	addChange("is_done", oldTask.IsDone, newTask.IsDone)
+	addChange("scheduled_date", toActivityDate(oldTask.ScheduledDate), toActivityDate(newTask.ScheduledDate))
+	addChange("has_time", oldTask.HasTime, newTask.HasTime)
+	addChange("scheduled_time", toActivityTime(oldTask.ScheduledTime, oldTask.HasTime), toActivityTime(newTask.ScheduledTime, newTask.HasTime))
+	addChange("duration_minutes", oldTask.DurationMinutes, newTask.DurationMinutes)
+	addChange("reschedule_count", oldTask.RescheduleCount, newTask.RescheduleCount)
+	addChange("priority", oldTask.Priority, newTask.Priority)
+	addChange("assignee_id", oldTask.AssigneeID, newTask.AssigneeID)
+	addChange("description", oldTask.Description, newTask.Description)
+
+	return changes
+}
+
+func toActivityDate(date time.Time) any {
+	if date.IsZero() {
+		return nil
+	}
+	return date.Format(time.DateOnly)
+}
+
+func toActivityTime(clock time.Time, hasTime bool) any {
+	if !hasTime {
+		return nil
+	}
+	return clock.Format("15:04")
+}
+
+func toTaskActivityOutputList(activity []repo.TaskActivity) ([]domain.TaskActivityOutput, error) {
+	output := make([]domain.TaskActivityOutput, len(activity))
+	for i, entry := range activity {
+		var changes map[string]taskFieldChange
+		if err := json.Unmarshal(entry.Changes, &changes); err != nil {
+			return nil, fmt.Errorf("couldn't decode task activity changes: %w", err)
+		}
+
+		output[i] = domain.TaskActivityOutput{
+			ID:        entry.ID,
+			TaskID:    entry.TaskID,
+			Action:    string(entry.Action),
+			Changes:   make(map[string]domain.TaskFieldChangeOutput, len(changes)),
+			CreatedAt: entry.CreatedAt.Time,
+		}
+		for field, change := range changes {
+			output[i].Changes[field] = domain.TaskFieldChangeOutput{
+				Old: change.Old,
+				New: change.New,
+			}
+		}
+	}
+	return output, nil
+}
+
+func toTaskPriority(priority string) repo.TaskPriority {
+	if priority == "" {
+		return repo.TaskPriorityNone
+	}
+
+	return repo.TaskPriority(priority)
+}
+
+func toPgBool(value *bool) pgtype.Bool {
+	if value == nil {
+		return pgtype.Bool{}
+	}
+
+	return pgtype.Bool{
+		Bool:  *value,
+		Valid: true,
+	}
+}
+
 func convertTimeToMicroseconds(t time.Time) int64 {
 	return int64(t.Hour())*3600000000 +
 		int64(t.Minute())*60000000 +
 		int64(t.Second())*1000000 +
 		int64(t.Nanosecond())/1000
 }
+
+// dateInLocation returns the calendar day of t as seen in loc, in the UTC-midnight form used for DATE columns.
+func dateInLocation(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// occurrenceOnDate returns the occurrence of a series starting at start that falls on the calendar day of date.
+func occurrenceOnDate(date time.Time, start time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+}
+
+// untilBeforeDate returns the last instant before the calendar day of date in loc, used as UNTIL when a series is cut at date.
+func untilBeforeDate(date time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc).Add(-1 * time.Second)
+}
+
+func combineDateAndTime(date time.Time, clock time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, time.UTC)
+}
+
+func datesFrom(dates []time.Time, from time.Time) []time.Time {
+	var output []time.Time
+	for _, date := range dates {
+		if !date.Before(from) {
+			output = append(output, date)
+		}
+	}
+	return output
+}
+
+func wallClockInLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
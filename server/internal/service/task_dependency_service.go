@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type taskDependencyService struct {
+	repo repo.Querier
+	pool *pgxpool.Pool
+}
+
+func NewTaskDependencyService(repo repo.Querier, pool *pgxpool.Pool) domain.TaskDependencyService {
+	return &taskDependencyService{
+		repo: repo,
+		pool: pool,
+	}
+}
+
+// ListTaskBlockers returns tasks blocking task, unfinished first, access to task is checked by caller.
+func (s *taskDependencyService) ListTaskBlockers(ctx context.Context, taskId int32) ([]domain.TaskOutput, error) {
+	blockers, err := s.repo.ListTaskBlockersByTaskID(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get task blockers: %w", err)
+	}
+
+	return domain.ToTaskOutputList(blockers), nil
+}
+
+// AddTaskDependency makes task blocked by blocker, dependencies are locked for the check,
+// so two concurrent additions can't close a cycle together.
+func (s *taskDependencyService) AddTaskDependency(ctx context.Context, task domain.TaskOutput, blocker domain.TaskOutput) error {
+	if task.ID == blocker.ID || task.UserID != blocker.UserID {
+		return domain.InvalidTaskDependencyError
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	err = qtx.LockTaskDependencies(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't lock task dependencies: %w", err)
+	}
+
+	// task blocked by blocker closes a cycle when blocker already waits for task
+	hasPath, err := qtx.HasTaskDependencyPath(ctx, repo.HasTaskDependencyPathParams{
+		FromTaskID: int32(blocker.ID),
+		ToTaskID:   int32(task.ID),
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't check task dependency path: %w", err)
+	}
+
+	if hasPath {
+		return domain.TaskDependencyCycleError
+	}
+
+	err = qtx.CreateTaskDependency(ctx, repo.CreateTaskDependencyParams{
+		TaskID:          int32(task.ID),
+		BlockedByTaskID: int32(blocker.ID),
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't create task dependency: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("couldn't commit transaction for adding task dependency: %w", err)
+	}
+
+	return nil
+}
+
+func (s *taskDependencyService) RemoveTaskDependency(ctx context.Context, taskId int32, blockerId int32) error {
+	removed, err := s.repo.DeleteTaskDependency(ctx, repo.DeleteTaskDependencyParams{
+		TaskID:          taskId,
+		BlockedByTaskID: blockerId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete task dependency: %w", err)
+	}
+
+	if removed == 0 {
+		return domain.TaskDependencyNotFoundError
+	}
+
+	return nil
+}
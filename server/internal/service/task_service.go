@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/ali-nur31/mile-do/internal/domain"
 	repo "github.com/ali-nur31/mile-do/internal/repository/db"
@@ -14,39 +15,62 @@ import (
 type taskService struct {
 	repo                          repo.Querier
 	pool                          *pgxpool.Pool
+	userService                   domain.UserService
 	recurringTasksTemplateService domain.RecurringTasksTemplateService
+	taskChecklistItemService      domain.TaskChecklistItemService
+	tagService                    domain.TagService
+	reminderService               domain.ReminderService
+	webhookService                domain.WebhookService
 }
 
-func NewTaskService(repo repo.Querier, pool *pgxpool.Pool, recurringTasksTemplateService domain.RecurringTasksTemplateService) domain.TaskService {
+func NewTaskService(repo repo.Querier, pool *pgxpool.Pool, userService domain.UserService, recurringTasksTemplateService domain.RecurringTasksTemplateService, taskChecklistItemService domain.TaskChecklistItemService, tagService domain.TagService, reminderService domain.ReminderService, webhookService domain.WebhookService) domain.TaskService {
 	return &taskService{
 		repo:                          repo,
 		pool:                          pool,
+		userService:                   userService,
 		recurringTasksTemplateService: recurringTasksTemplateService,
+		taskChecklistItemService:      taskChecklistItemService,
+		tagService:                    tagService,
+		reminderService:               reminderService,
+		webhookService:                webhookService,
 	}
 }
 
-func (s *taskService) ListTasksByGoalID(ctx context.Context, userId int32, goalId int32) ([]domain.TaskOutput, error) {
-	tasks, err := s.repo.ListTasksByGoalID(ctx, repo.ListTasksByGoalIDParams{
-		UserID: userId,
-		GoalID: goalId,
-	})
+func (s *taskService) ListInboxTasks(ctx context.Context, userId int32) ([]domain.TaskOutput, error) {
+	tasks, err := s.repo.ListInboxTasks(ctx, userId)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't get tasks by goal id: %w", err)
+		return nil, fmt.Errorf("couldn't get inbox tasks: %w", err)
 	}
 
-	return domain.ToTaskOutputList(tasks), nil
+	return s.fillTaskDetailsInternal(ctx, domain.ToTaskOutputList(tasks))
 }
 
-func (s *taskService) ListInboxTasks(ctx context.Context, userId int32) ([]domain.TaskOutput, error) {
-	tasks, err := s.repo.ListInboxTasks(ctx, userId)
+// ListAssignedTasks returns open tasks assigned to user, own ones and ones of shared goals.
+func (s *taskService) ListAssignedTasks(ctx context.Context, userId int32) ([]domain.TaskOutput, error) {
+	tasks, err := s.repo.ListAssignedTasks(ctx, userId)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't get inbox tasks: %w", err)
+		return nil, fmt.Errorf("couldn't get assigned tasks: %w", err)
 	}
 
-	return domain.ToTaskOutputList(tasks), nil
+	return s.fillTaskDetailsInternal(ctx, domain.ToTaskOutputList(tasks))
 }
 
 func (s *taskService) ListTasksByPeriod(ctx context.Context, period domain.GetTasksByPeriodInput) ([]domain.TaskOutput, error) {
+	if period.AfterDate.IsZero() || period.BeforeDate.IsZero() {
+		loc, err := s.userService.GetUserLocation(ctx, nil, int64(period.UserID))
+		if err != nil {
+			return nil, err
+		}
+
+		today := dateInLocation(time.Now(), loc)
+		if period.AfterDate.IsZero() {
+			period.AfterDate = today
+		}
+		if period.BeforeDate.IsZero() {
+			period.BeforeDate = today
+		}
+	}
+
 	tasks, err := s.repo.ListTasksByDateRange(ctx, repo.ListTasksByDateRangeParams{
 		UserID: period.UserID,
 		ScheduledDate: pgtype.Date{
@@ -57,21 +81,134 @@ func (s *taskService) ListTasksByPeriod(ctx context.Context, period domain.GetTa
 			Time:  period.BeforeDate,
 			Valid: true,
 		},
+		TagID: pgtype.Int4{
+			Int32: period.TagID,
+			Valid: period.TagID != 0,
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get tasks by period: %w", err)
 	}
 
-	return domain.ToTaskOutputList(tasks), nil
+	return s.fillTaskDetailsInternal(ctx, domain.ToTaskOutputList(tasks))
 }
 
-func (s *taskService) ListTasks(ctx context.Context, userId int32) ([]domain.TaskOutput, error) {
-	tasks, err := s.repo.ListTasks(ctx, userId)
+func (s *taskService) ListTasks(ctx context.Context, input domain.ListTasksInput) (*domain.TaskPageOutput, error) {
+	params := repo.ListTasksPageParams{
+		UserID: input.UserID,
+		GoalID: pgtype.Int4{
+			Int32: input.GoalID,
+			Valid: input.GoalID != 0,
+		},
+		TagID: pgtype.Int4{
+			Int32: input.TagID,
+			Valid: input.TagID != 0,
+		},
+		IsDone:  toPgBool(input.IsDone),
+		HasTime: toPgBool(input.HasTime),
+		RecurringTemplateID: pgtype.Int4{
+			Int32: input.RecurringTemplateID,
+			Valid: input.RecurringTemplateID != 0,
+		},
+		ScheduledAfter: pgtype.Date{
+			Time:  input.ScheduledAfter,
+			Valid: !input.ScheduledAfter.IsZero(),
+		},
+		ScheduledBefore: pgtype.Date{
+			Time:  input.ScheduledBefore,
+			Valid: !input.ScheduledBefore.IsZero(),
+		},
+		RowLimit: pgtype.Int4{
+			Int32: input.Limit + 1,
+			Valid: input.Limit > 0,
+		},
+	}
+
+	if input.Cursor != nil {
+		params.CursorID = pgtype.Int8{
+			Int64: input.Cursor.ID,
+			Valid: true,
+		}
+		params.CursorPriority = repo.NullTaskPriority{
+			TaskPriority: toTaskPriority(input.Cursor.Priority),
+			Valid:        true,
+		}
+		params.CursorIsDone = pgtype.Bool{
+			Bool:  input.Cursor.IsDone,
+			Valid: true,
+		}
+	}
+
+	tasks, err := s.repo.ListTasksPage(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get tasks: %w", err)
 	}
 
-	return domain.ToTaskOutputList(tasks), nil
+	var nextCursor *domain.TaskCursor
+	if input.Limit > 0 && int32(len(tasks)) > input.Limit {
+		tasks = tasks[:input.Limit]
+		last := tasks[len(tasks)-1]
+		nextCursor = &domain.TaskCursor{
+			Priority: string(last.Priority),
+			IsDone:   last.IsDone,
+			ID:       last.ID,
+		}
+	}
+
+	outTasks, err := s.fillTaskDetailsInternal(ctx, domain.ToTaskOutputList(tasks))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TaskPageOutput{
+		Tasks:      outTasks,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *taskService) SearchTasks(ctx context.Context, input domain.SearchTasksInput) ([]domain.TaskOutput, error) {
+	tasks, err := s.repo.SearchTasks(ctx, repo.SearchTasksParams{
+		UserID: input.UserID,
+		Query:  input.Query,
+		GoalID: pgtype.Int4{
+			Int32: input.GoalID,
+			Valid: input.GoalID != 0,
+		},
+		IsDone: toPgBool(input.IsDone),
+		AfterDate: pgtype.Date{
+			Time:  input.AfterDate,
+			Valid: !input.AfterDate.IsZero(),
+		},
+		BeforeDate: pgtype.Date{
+			Time:  input.BeforeDate,
+			Valid: !input.BeforeDate.IsZero(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't search tasks: %w", err)
+	}
+
+	return s.fillTaskDetailsInternal(ctx, domain.ToTaskOutputList(tasks))
+}
+
+func (s *taskService) ListOverdueTasks(ctx context.Context, userId int32) ([]domain.TaskOutput, error) {
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.repo.ListOverdueTasks(ctx, repo.ListOverdueTasksParams{
+		UserID: userId,
+		ScheduledDate: pgtype.Date{
+			Time:  dateInLocation(time.Now(), loc),
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get overdue tasks: %w", err)
+	}
+
+	return s.fillTaskDetailsInternal(ctx, domain.ToTaskOutputList(tasks))
 }
 
 func (s *taskService) GetTaskByID(ctx context.Context, id int64, userId int32) (*domain.TaskOutput, error) {
@@ -83,36 +220,73 @@ func (s *taskService) GetTaskByID(ctx context.Context, id int64, userId int32) (
 		return nil, fmt.Errorf("couldn't get task by id: %w", err)
 	}
 
-	return domain.ToTaskOutput(&task), nil
+	return s.fillTaskDetailsSingleInternal(ctx, domain.ToTaskOutput(&task))
+}
+
+// ListTaskActivity returns history of task newest first, access to task is checked by caller.
+func (s *taskService) ListTaskActivity(ctx context.Context, taskId int64) ([]domain.TaskActivityOutput, error) {
+	activity, err := s.repo.ListTaskActivityByTaskID(ctx, int32(taskId))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get task activity: %w", err)
+	}
+
+	return toTaskActivityOutputList(activity)
 }
 
 func (s *taskService) CreateTask(ctx context.Context, input domain.CreateTaskInput) (*domain.TaskOutput, error) {
-	task, err := s.repo.CreateTask(ctx, repo.CreateTaskParams{
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	task, err := s.createTaskInternal(ctx, qtx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for creating task: %w", err)
+	}
+
+	return s.fillTaskDetailsAndDispatchInternal(ctx, task, domain.WebhookEventTaskCreated)
+}
+
+// ImportTask creates task inside transaction of importer, tasks done in the source are created as done.
+func (s *taskService) ImportTask(ctx context.Context, qtx repo.Querier, input domain.CreateTaskInput, isDone bool) (*domain.TaskOutput, error) {
+	task, err := s.createTaskInternal(ctx, qtx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isDone {
+		return task, nil
+	}
+
+	doneTask, err := qtx.UpdateIsDoneInTaskByID(ctx, repo.UpdateIsDoneInTaskByIDParams{
+		ID:     task.ID,
 		UserID: input.UserID,
-		GoalID: input.GoalID,
-		Title:  input.Title,
-		ScheduledDate: pgtype.Date{
-			Time:  input.ScheduledDate,
-			Valid: !input.ScheduledDate.IsZero(),
-		},
-		HasTime: input.HasTime,
-		ScheduledTime: pgtype.Time{
-			Microseconds: convertTimeToMicroseconds(input.ScheduledTime),
-			Valid:        input.HasTime,
-		},
-		DurationMinutes: pgtype.Int4{
-			Int32: input.DurationMinutes,
-			Valid: true,
-		},
+		IsDone: true,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("couldn't create task: %w", err)
+		return nil, fmt.Errorf("couldn't complete task: %w", err)
 	}
 
-	return domain.ToTaskOutput(&task), nil
+	return domain.ToTaskOutput(&doneTask), nil
 }
 
 func (s *taskService) UpdateTask(ctx context.Context, dbTask domain.TaskOutput, updatingTask domain.UpdateTaskInput) (*domain.TaskOutput, error) {
+	if !dbTask.IsDone && updatingTask.IsDone {
+		err := s.checkTaskNotBlockedInternal(ctx, s.repo, dbTask.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if !dbTask.ScheduledDate.IsZero() && !dbTask.ScheduledDate.Equal(updatingTask.ScheduledDate) {
 		updatingTask.RescheduleCount += 1
 	}
@@ -121,8 +295,13 @@ func (s *taskService) UpdateTask(ctx context.Context, dbTask domain.TaskOutput,
 		ID:     updatingTask.ID,
 		UserID: updatingTask.UserID,
 		GoalID: updatingTask.GoalID,
-		Title:  updatingTask.Title,
-		IsDone: updatingTask.IsDone,
+		RecurringTemplateID: pgtype.Int4{
+			Int32: dbTask.RecurringTemplateID,
+			Valid: dbTask.RecurringTemplateID != 0,
+		},
+		Title:       updatingTask.Title,
+		Description: updatingTask.Description,
+		IsDone:      updatingTask.IsDone,
 		ScheduledDate: pgtype.Date{
 			Time:  updatingTask.ScheduledDate,
 			Valid: !updatingTask.ScheduledDate.IsZero(),
@@ -136,17 +315,138 @@ func (s *taskService) UpdateTask(ctx context.Context, dbTask domain.TaskOutput,
 			Int32: updatingTask.DurationMinutes,
 			Valid: true,
 		},
+		RescheduleCount:       updatingTask.RescheduleCount,
+		Priority:              toTaskPriority(updatingTask.Priority),
+		IsRecurrenceException: dbTask.IsRecurrenceException,
+		AssigneeID: pgtype.Int4{
+			Int32: updatingTask.AssigneeID,
+			Valid: updatingTask.AssigneeID != 0,
+		},
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
 
-	task, err := s.repo.UpdateTaskByID(ctx, taskUpdatingParams)
+	qtx := repo.New(tx)
+
+	var splitTemplate *domain.RecurringTasksTemplateOutput
+	if dbTask.RecurringTemplateID != 0 {
+		updatingTask.Scope, err = s.resolveRecurrenceScopeInternal(ctx, dbTask, updatingTask.Scope)
+		if err != nil {
+			return nil, err
+		}
+
+		switch updatingTask.Scope {
+		case domain.RecurrenceScopeFollowing:
+			splitTemplate, err = s.splitRecurringTaskSeriesInternal(ctx, qtx, dbTask, updatingTask)
+			if err != nil {
+				return nil, err
+			}
+			taskUpdatingParams.RecurringTemplateID.Int32 = int32(splitTemplate.ID)
+		case domain.RecurrenceScopeAll:
+			err = s.updateRecurringTaskSeriesInternal(ctx, qtx, dbTask, updatingTask)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			// the occurrence is detached from the series, so regeneration neither wipes nor duplicates it
+			err = s.recurringTasksTemplateService.AddExdateToRecurringTasksTemplateByID(ctx, qtx, int64(dbTask.RecurringTemplateID), dbTask.UserID, dbTask.ScheduledDate)
+			if err != nil {
+				return nil, err
+			}
+			taskUpdatingParams.IsRecurrenceException = true
+		}
+	}
+
+	task, err := qtx.UpdateTaskByID(ctx, taskUpdatingParams)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't update task: %w", err)
 	}
 
-	return domain.ToTaskOutput(&task), nil
+	action := domain.TaskActivityUpdated
+	if task.RescheduleCount > dbTask.RescheduleCount {
+		action = domain.TaskActivityRescheduled
+	}
+
+	if changes := diffTaskFields(dbTask, *domain.ToTaskOutput(&task)); len(changes) > 0 {
+		err = s.recordTaskActivityInternal(ctx, qtx, task.ID, action, changes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// completing task through update has the same effects as CompleteTask
+	completed := !dbTask.IsDone && task.IsDone
+	if completed {
+		err = s.finishTaskCompletionInternal(ctx, qtx, task, dbTask.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if updatingTask.TagIDs != nil {
+		err = s.tagService.SetTaskTags(ctx, qtx, int32(task.ID), updatingTask.UserID, updatingTask.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if splitTemplate != nil {
+		err = s.deleteTasksFromDateByRecurringTasksTemplateIDInternal(ctx, qtx, dbTask.RecurringTemplateID, dbTask.UserID, dbTask.ScheduledDate)
+		if err != nil {
+			return nil, err
+		}
+
+		splitTemplate.LastGeneratedDate = task.ScheduledDate.Time
+		err = s.CreateTasksByTemplateInternal(ctx, *splitTemplate, qtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for updating task: %w", err)
+	}
+
+	outTask := domain.ToTaskOutput(&task)
+
+	if completed {
+		err = s.reminderService.CancelTaskReminders(ctx, task.ID, dbTask.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.fillTaskDetailsAndDispatchInternal(ctx, outTask, domain.WebhookEventTaskCompleted)
+	}
+
+	err = s.reminderService.ScheduleTaskReminders(ctx, nil, *outTask)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fillTaskDetailsAndDispatchInternal(ctx, outTask, domain.WebhookEventTaskUpdated)
 }
 
-func (s *taskService) CompleteTask(ctx context.Context, userId int32, taskId int64) (*domain.TaskOutput, error) {
+func (s *taskService) CompleteTask(ctx context.Context, userId int32, taskId int64, withChecklistItems bool) (*domain.TaskOutput, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	err = s.checkTaskNotBlockedInternal(ctx, qtx, taskId)
+	if err != nil {
+		return nil, err
+	}
 
 	taskUpdatingParams := repo.UpdateIsDoneInTaskByIDParams{
 		ID:     taskId,
@@ -154,16 +454,219 @@ func (s *taskService) CompleteTask(ctx context.Context, userId int32, taskId int
 		IsDone: true,
 	}
 
-	task, err := s.repo.UpdateIsDoneInTaskByID(ctx, taskUpdatingParams)
+	task, err := qtx.UpdateIsDoneInTaskByID(ctx, taskUpdatingParams)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't complete task: %w", err)
+	}
+
+	err = s.finishTaskCompletionInternal(ctx, qtx, task, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if withChecklistItems {
+		err = s.taskChecklistItemService.CompleteTaskChecklistItemsByTaskID(ctx, qtx, int32(taskId))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for completing task: %w", err)
+	}
+
+	err = s.reminderService.CancelTaskReminders(ctx, taskId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fillTaskDetailsAndDispatchInternal(ctx, domain.ToTaskOutput(&task), domain.WebhookEventTaskCompleted)
+}
+
+// UncompleteTask reopens task, for a completion-based template the occurrence created by completing it is removed again.
+func (s *taskService) UncompleteTask(ctx context.Context, dbTask domain.TaskOutput) (*domain.TaskOutput, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	if dbTask.IsDone && dbTask.RecurringTemplateID != 0 {
+		err = s.deleteNextTaskAfterCompletionInternal(ctx, qtx, dbTask)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	task, err := qtx.UpdateIsDoneInTaskByID(ctx, repo.UpdateIsDoneInTaskByIDParams{
+		ID:     dbTask.ID,
+		UserID: dbTask.UserID,
+		IsDone: false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't uncomplete task: %w", err)
+	}
+
+	err = s.recordTaskActivityInternal(ctx, qtx, task.ID, domain.TaskActivityUncompleted, diffTaskFields(dbTask, *domain.ToTaskOutput(&task)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for uncompleting task: %w", err)
+	}
+
+	outTask := domain.ToTaskOutput(&task)
+
+	err = s.reminderService.ScheduleTaskReminders(ctx, nil, *outTask)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fillTaskDetailsAndDispatchInternal(ctx, outTask, domain.WebhookEventTaskUpdated)
+}
+
+// CheckInRecurringTask completes the occurrence of template on date, only that one task is created when it doesn't exist yet.
+func (s *taskService) CheckInRecurringTask(ctx context.Context, template domain.RecurringTasksTemplateOutput, date time.Time) (*domain.TaskOutput, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	tasks, err := qtx.ListRecurringTasksByDateRange(ctx, repo.ListRecurringTasksByDateRangeParams{
+		UserID: template.UserID,
+		RecurringTemplateID: pgtype.Int4{
+			Int32: int32(template.ID),
+			Valid: true,
+		},
+		AfterDate: pgtype.Date{
+			Time:  date,
+			Valid: true,
+		},
+		BeforeDate: pgtype.Date{
+			Time:  date,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get recurring tasks by date range: %w", err)
+	}
+
+	for _, task := range tasks {
+		if task.IsDone {
+			return s.fillTaskDetailsSingleInternal(ctx, domain.ToTaskOutput(&task))
+		}
+	}
+
+	var task *domain.TaskOutput
+	if len(tasks) > 0 {
+		task = domain.ToTaskOutput(&tasks[0])
+	} else {
+		templateTagIds, err := s.tagService.ListTagIDsByRecurringTasksTemplateIDs(ctx, qtx, []int32{int32(template.ID)})
+		if err != nil {
+			return nil, err
+		}
+
+		task, err = s.createTaskByTemplateInternal(ctx, qtx, template, date, template.ScheduledDatetime, templateTagIds[int32(template.ID)])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	doneTask, err := qtx.UpdateIsDoneInTaskByID(ctx, repo.UpdateIsDoneInTaskByIDParams{
+		ID:     task.ID,
+		UserID: template.UserID,
+		IsDone: true,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("couldn't complete task: %w", err)
 	}
 
-	return domain.ToTaskOutput(&task), nil
+	err = s.recordTaskActivityInternal(ctx, qtx, doneTask.ID, domain.TaskActivityCompleted, diffTaskFields(*task, *domain.ToTaskOutput(&doneTask)))
+	if err != nil {
+		return nil, err
+	}
+
+	// completing the open occurrence of a completion-based series schedules the next one, as in CompleteTask
+	if len(tasks) > 0 {
+		err = s.createNextTaskAfterCompletionInternal(ctx, qtx, int32(template.ID), template.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("couldn't commit transaction for checking in recurring task: %w", err)
+	}
+
+	return s.fillTaskDetailsAndDispatchInternal(ctx, domain.ToTaskOutput(&doneTask), domain.WebhookEventTaskCompleted)
+}
+
+// GetGoalStreak counts consecutive days in user's time zone on which a task of goal was completed.
+// Streak belongs to goal, so on shared goal tasks completed by any member count, access to goal is checked by caller.
+func (s *taskService) GetGoalStreak(ctx context.Context, goalId int64, userId int32) (*domain.StreakOutput, error) {
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	completedAts, err := s.repo.ListCompletedAtByGoalID(ctx, int32(goalId))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get completion times by goal id: %w", err)
+	}
+
+	return dayStreaks(completedAts, loc, dateInLocation(time.Now(), loc)), nil
+}
+
+// GetRecurringTasksTemplateStreak counts consecutive occurrences of template completed no later than their day.
+// Like goal streak it belongs to template, occurrences of shared template count whoever completed them, access is checked by caller.
+func (s *taskService) GetRecurringTasksTemplateStreak(ctx context.Context, templateId int64, userId int32) (*domain.StreakOutput, error) {
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	today := dateInLocation(time.Now(), loc)
+
+	occurrences, err := s.repo.ListOccurrencesByRecurringTasksTemplateID(ctx, repo.ListOccurrencesByRecurringTasksTemplateIDParams{
+		RecurringTemplateID: pgtype.Int4{
+			Int32: int32(templateId),
+			Valid: true,
+		},
+		ScheduledDate: pgtype.Date{
+			Time:  today,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get occurrences by recurring tasks template id: %w", err)
+	}
+
+	return occurrenceStreaks(occurrences, loc, today), nil
 }
 
 func (s *taskService) AnalyzeForToday(ctx context.Context, userId int32) (*domain.TodayProgressOutput, error) {
-	stats, err := s.repo.CountCompletedTasksForToday(ctx, userId)
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.repo.CountCompletedTasksForToday(ctx, repo.CountCompletedTasksForTodayParams{
+		UserID: userId,
+		ScheduledDate: pgtype.Date{
+			Time:  dateInLocation(time.Now(), loc),
+			Valid: true,
+		},
+	})
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get tasks statistics for today: %w", err)
 	}
@@ -175,7 +678,22 @@ func (s *taskService) AnalyzeForToday(ctx context.Context, userId int32) (*domai
 }
 
 func (s *taskService) DeleteTaskByID(ctx context.Context, id int64, userId int32) error {
-	err := s.repo.DeleteTaskByID(ctx, repo.DeleteTaskByIDParams{
+	err := s.reminderService.CancelTaskReminders(ctx, id, userId)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	deleted, err := qtx.DeleteTaskByID(ctx, repo.DeleteTaskByIDParams{
 		ID:     id,
 		UserID: userId,
 	})
@@ -183,6 +701,100 @@ func (s *taskService) DeleteTaskByID(ctx context.Context, id int64, userId int32
 		return fmt.Errorf("couldn't delete task by id: %w", err)
 	}
 
+	if deleted == 0 {
+		return domain.TaskNotFoundError
+	}
+
+	err = s.recordTaskActivityInternal(ctx, qtx, id, domain.TaskActivityDeleted, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("couldn't commit transaction for deleting task: %w", err)
+	}
+
+	s.webhookService.DispatchEvent(ctx, userId, domain.WebhookEventTaskDeleted, domain.WebhookDeletedData{ID: id})
+
+	return nil
+}
+
+func (s *taskService) DeleteRecurringTaskByID(ctx context.Context, dbTask domain.TaskOutput, scope domain.RecurrenceScope) error {
+	scope, err := s.resolveRecurrenceScopeInternal(ctx, dbTask, scope)
+	if err != nil {
+		return err
+	}
+
+	err = s.reminderService.CancelTaskReminders(ctx, dbTask.ID, dbTask.UserID)
+	if err != nil {
+		return err
+	}
+
+	if scope == domain.RecurrenceScopeAll {
+		err = s.recurringTasksTemplateService.DeleteRecurringTasksTemplateByID(ctx, int64(dbTask.RecurringTemplateID), dbTask.UserID)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordTaskActivityInternal(ctx, s.repo, dbTask.ID, domain.TaskActivityDeleted, nil)
+		if err != nil {
+			return err
+		}
+
+		s.webhookService.DispatchEvent(ctx, dbTask.UserID, domain.WebhookEventTaskDeleted, domain.WebhookDeletedData{ID: dbTask.ID})
+		return nil
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	qtx := repo.New(tx)
+
+	if scope == domain.RecurrenceScopeFollowing {
+		err = s.endRecurringTaskSeriesInternal(ctx, qtx, dbTask)
+	} else {
+		err = s.recurringTasksTemplateService.AddExdateToRecurringTasksTemplateByID(ctx, qtx, int64(dbTask.RecurringTemplateID), dbTask.UserID, dbTask.ScheduledDate)
+	}
+	if err != nil {
+		return err
+	}
+
+	deleted, err := qtx.DeleteTaskByID(ctx, repo.DeleteTaskByIDParams{
+		ID:     dbTask.ID,
+		UserID: dbTask.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete task by id: %w", err)
+	}
+
+	if deleted == 0 {
+		return domain.TaskNotFoundError
+	}
+
+	err = s.recordTaskActivityInternal(ctx, qtx, dbTask.ID, domain.TaskActivityDeleted, nil)
+	if err != nil {
+		return err
+	}
+
+	if scope != domain.RecurrenceScopeFollowing && !dbTask.IsDone {
+		// skipping an open occurrence of a completion-based series moves the series on as if it was completed
+		err = s.createNextTaskAfterCompletionInternal(ctx, qtx, dbTask.RecurringTemplateID, dbTask.UserID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("couldn't commit transaction for deleting recurring task: %w", err)
+	}
+
+	s.webhookService.DispatchEvent(ctx, dbTask.UserID, domain.WebhookEventTaskDeleted, domain.WebhookDeletedData{ID: dbTask.ID})
+
 	return nil
 }
 
@@ -198,6 +810,16 @@ func (s *taskService) DeleteFutureTasksByRecurringTasksTemplateID(ctx context.Co
 	return nil
 }
 
+// RollOverOverdueTasks moves overdue tasks of users with auto rollover to their today, the query records the reschedule in task activity.
+func (s *taskService) RollOverOverdueTasks(ctx context.Context) (int64, error) {
+	rolledOver, err := s.repo.RollOverOverdueTasks(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't roll over overdue tasks: %w", err)
+	}
+
+	return rolledOver, nil
+}
+
 func (s *taskService) CreateTasksByRecurringTasksTemplatesDueForGeneration(ctx context.Context, qtx repo.Querier) error {
 	templates, err := s.recurringTasksTemplateService.ListRecurringTasksTemplatesDueForGeneration(ctx, qtx)
 	if err != nil {
@@ -209,20 +831,7 @@ func (s *taskService) CreateTasksByRecurringTasksTemplatesDueForGeneration(ctx c
 	}
 
 	for _, template := range templates {
-		outTemplate := domain.RecurringTasksTemplateOutput{
-			ID:                template.ID,
-			UserID:            template.UserID,
-			GoalID:            template.GoalID,
-			Title:             template.Title,
-			ScheduledDatetime: template.ScheduledDatetime,
-			HasTime:           template.HasTime,
-			DurationMinutes:   template.DurationMinutes,
-			RecurrenceRrule:   template.RecurrenceRrule,
-			LastGeneratedDate: template.LastGeneratedDate,
-			CreatedAt:         template.CreatedAt,
-		}
-
-		err = s.CreateTasksByRecurringTasksTemplate(ctx, qtx, outTemplate)
+		err = s.CreateTasksByRecurringTasksTemplate(ctx, qtx, template)
 		if err != nil {
 			slog.Error("failed to process template", "template_id", template.ID, "error", err)
 			continue
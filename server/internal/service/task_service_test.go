@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+)
+
+type generationQuerier struct {
+	repo.Querier
+	rotation     int32
+	createdTasks []repo.CreateTaskParams
+}
+
+func (q *generationQuerier) GetRoleInGoalMemberByIDs(ctx context.Context, arg repo.GetRoleInGoalMemberByIDsParams) (repo.GoalMemberRole, error) {
+	return repo.GoalMemberRoleEditor, nil
+}
+
+func (q *generationQuerier) IncrementAssigneeRotationInRecurringTasksTemplateByID(ctx context.Context, id int64) (int32, error) {
+	q.rotation++
+	return q.rotation, nil
+}
+
+func (q *generationQuerier) CreateTask(ctx context.Context, arg repo.CreateTaskParams) (repo.Task, error) {
+	q.createdTasks = append(q.createdTasks, arg)
+	return repo.Task{
+		ID:         int64(len(q.createdTasks)),
+		UserID:     arg.UserID,
+		GoalID:     arg.GoalID,
+		AssigneeID: arg.AssigneeID,
+	}, nil
+}
+
+type generationUserService struct {
+	domain.UserService
+}
+
+func (s generationUserService) GetUserLocation(ctx context.Context, qtx repo.Querier, userId int64) (*time.Location, error) {
+	return time.UTC, nil
+}
+
+type generationTemplateService struct {
+	domain.RecurringTasksTemplateService
+	templates []domain.RecurringTasksTemplateOutput
+}
+
+func (s generationTemplateService) ListRecurringTasksTemplatesDueForGeneration(ctx context.Context, qtx repo.Querier) ([]domain.RecurringTasksTemplateOutput, error) {
+	return s.templates, nil
+}
+
+func (s generationTemplateService) UpdateLastGeneratedDateInRecurringTasksTemplateByID(ctx context.Context, qtx repo.Querier, updatingTemplate domain.UpdateLastGeneratedDateInRecurringTasksTemplateInput) error {
+	return nil
+}
+
+type generationTagService struct {
+	domain.TagService
+}
+
+func (s generationTagService) ListTagIDsByRecurringTasksTemplateIDs(ctx context.Context, qtx repo.Querier, templateIds []int32) (map[int32][]int32, error) {
+	return map[int32][]int32{}, nil
+}
+
+type generationReminderService struct {
+	domain.ReminderService
+}
+
+func (s generationReminderService) ScheduleTaskReminders(ctx context.Context, qtx repo.Querier, task domain.TaskOutput) error {
+	return nil
+}
+
+func TestCreateTasksByRecurringTasksTemplatesDueForGenerationRotatesAssignees(t *testing.T) {
+	qtx := &generationQuerier{}
+	s := &taskService{
+		userService: generationUserService{},
+		recurringTasksTemplateService: generationTemplateService{
+			templates: []domain.RecurringTasksTemplateOutput{
+				{
+					ID:                10,
+					UserID:            1,
+					GoalID:            5,
+					Title:             "take out trash",
+					ScheduledDatetime: time.Now().UTC(),
+					RecurrenceRrule:   "RRULE:FREQ=DAILY;COUNT=3",
+					RecurrenceMode:    string(repo.RecurrenceModeCalendar),
+					Priority:          string(repo.TaskPriorityNone),
+					AssigneeIDs:       []int32{1, 2},
+				},
+			},
+		},
+		tagService:      generationTagService{},
+		reminderService: generationReminderService{},
+	}
+
+	err := s.CreateTasksByRecurringTasksTemplatesDueForGeneration(context.Background(), qtx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int32{1, 2, 1}
+	if len(qtx.createdTasks) != len(want) {
+		t.Fatalf("created %d tasks, want %d", len(qtx.createdTasks), len(want))
+	}
+
+	for i, task := range qtx.createdTasks {
+		if !task.AssigneeID.Valid || task.AssigneeID.Int32 != want[i] {
+			t.Errorf("task %d assigned to %v, want %d", i, task.AssigneeID, want[i])
+		}
+	}
+
+	if qtx.rotation != int32(len(want)) {
+		t.Errorf("rotation advanced %d times, want %d", qtx.rotation, len(want))
+	}
+}
@@ -2,7 +2,12 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/ali-nur31/mile-do/internal/domain"
 	repo "github.com/ali-nur31/mile-do/internal/repository/db"
@@ -24,3 +29,32 @@ func (s *userService) createUserInternal(ctx context.Context, qtx repo.Querier,
 
 	return domain.ToUserOutput(&savedUser), nil
 }
+
+func (s *userService) getUserLocationInternal(ctx context.Context, qtx repo.Querier, id int64) (*time.Location, error) {
+	user, err := qtx.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get user by id for time zone: %w", err)
+	}
+
+	loc, err := time.LoadLocation(user.TimeZone)
+	if err != nil {
+		slog.Error("couldn't load user time zone, falling back to UTC", "user_id", id, "time_zone", user.TimeZone, "error", err)
+		return time.UTC, nil
+	}
+
+	return loc, nil
+}
+
+func generateCalendarToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("couldn't generate calendar token: %w", err)
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
+func hashCalendarToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
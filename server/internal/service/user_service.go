@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ali-nur31/mile-do/internal/domain"
 	"github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type userService struct {
@@ -41,3 +43,76 @@ func (s *userService) GetUserByID(ctx context.Context, id int64) (*domain.UserOu
 func (s *userService) CreateUser(ctx context.Context, qtx repo.Querier, user domain.AuthInput) (*domain.UserOutput, error) {
 	return s.createUserInternal(ctx, qtx, user)
 }
+
+func (s *userService) UpdateUser(ctx context.Context, input domain.UpdateUserInput) (*domain.UserOutput, error) {
+	if _, err := time.LoadLocation(input.TimeZone); err != nil {
+		return nil, fmt.Errorf("unknown time zone %q: %w", input.TimeZone, err)
+	}
+
+	user, err := s.repo.UpdateSettingsInUserByID(ctx, repo.UpdateSettingsInUserByIDParams{
+		ID:                 input.ID,
+		TimeZone:           input.TimeZone,
+		AutoRollover:       input.AutoRollover,
+		EmailNotifications: input.EmailNotifications,
+		EmailAgenda:        input.EmailAgenda,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't update user: %w", err)
+	}
+
+	return domain.ToUserOutput(&user), nil
+}
+
+func (s *userService) GetUserByCalendarToken(ctx context.Context, token string) (*domain.UserOutput, error) {
+	user, err := s.repo.GetUserByCalendarTokenHash(ctx, pgtype.Text{
+		String: hashCalendarToken(token),
+		Valid:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get user by calendar token: %w", err)
+	}
+
+	return domain.ToUserOutput(&user), nil
+}
+
+// RotateCalendarToken replaces the calendar feed token of user, so the old feed url stops working.
+// Only the hash is stored, the returned token cannot be recovered later.
+func (s *userService) RotateCalendarToken(ctx context.Context, id int64) (string, error) {
+	token, err := generateCalendarToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.repo.UpdateCalendarTokenHashInUserByID(ctx, repo.UpdateCalendarTokenHashInUserByIDParams{
+		ID: id,
+		CalendarTokenHash: pgtype.Text{
+			String: hashCalendarToken(token),
+			Valid:  true,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("couldn't rotate calendar token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (s *userService) RevokeCalendarToken(ctx context.Context, id int64) error {
+	_, err := s.repo.UpdateCalendarTokenHashInUserByID(ctx, repo.UpdateCalendarTokenHashInUserByIDParams{
+		ID:                id,
+		CalendarTokenHash: pgtype.Text{},
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't revoke calendar token: %w", err)
+	}
+
+	return nil
+}
+
+func (s *userService) GetUserLocation(ctx context.Context, qtx repo.Querier, id int64) (*time.Location, error) {
+	if qtx == nil {
+		return s.getUserLocationInternal(ctx, s.repo, id)
+	}
+
+	return s.getUserLocationInternal(ctx, qtx, id)
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type webhookEnvelope struct {
+	Event      string `json:"event"`
+	UserID     int32  `json:"user_id"`
+	OccurredAt string `json:"occurred_at"`
+	Data       any    `json:"data"`
+}
+
+func (s *webhookService) dispatchEventInternal(ctx context.Context, userId int32, event string, data any) error {
+	webhooks, err := s.repo.ListActiveWebhooksByEvent(ctx, repo.ListActiveWebhooksByEventParams{
+		UserID: userId,
+		Event:  event,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't get webhooks by event: %w", err)
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(webhookEnvelope{
+		Event:      event,
+		UserID:     userId,
+		OccurredAt: time.Now().UTC().Format(time.RFC3339),
+		Data:       data,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't encode webhook payload: %w", err)
+	}
+
+	var errs []error
+	for _, webhook := range webhooks {
+		delivery, err := s.repo.CreateWebhookDelivery(ctx, repo.CreateWebhookDeliveryParams{
+			WebhookID: int32(webhook.ID),
+			Event:     event,
+			Payload:   body,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("couldn't create webhook delivery: %w", err))
+			continue
+		}
+
+		_, err = s.asynq.Enqueue(domain.NewDeliverWebhookTask(&domain.WebhookDeliveryPayload{
+			DeliveryID: delivery.ID,
+		}), asynq.Queue("default"), asynq.MaxRetry(webhookMaxRetry))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("couldn't enqueue webhook delivery: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *webhookService) recordWebhookAttemptInternal(ctx context.Context, deliveryId int64, status repo.WebhookDeliveryStatus, statusCode int, cause error) error {
+	params := repo.UpdateAttemptInWebhookDeliveryByIDParams{
+		ID:     deliveryId,
+		Status: status,
+		ResponseStatus: pgtype.Int4{
+			Int32: int32(statusCode),
+			Valid: statusCode != 0,
+		},
+	}
+	if cause != nil {
+		params.Error = pgtype.Text{
+			String: cause.Error(),
+			Valid:  true,
+		}
+	}
+
+	err := s.repo.UpdateAttemptInWebhookDeliveryByID(ctx, params)
+	if err != nil {
+		return fmt.Errorf("couldn't record webhook delivery attempt: %w", err)
+	}
+
+	return nil
+}
+
+func isLastWebhookAttempt(ctx context.Context) bool {
+	retried, ok := asynq.GetRetryCount(ctx)
+	if !ok {
+		return true
+	}
+
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	if !ok {
+		return true
+	}
+
+	return retried >= maxRetry
+}
+
+// normalizeWebhookEvents checks events and drops duplicates, keeping order of domain.WebhookEvents.
+func normalizeWebhookEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: at least one event is required", domain.InvalidWebhookEventError)
+	}
+
+	for _, event := range events {
+		if !domain.IsWebhookEvent(event) {
+			return nil, fmt.Errorf("%w: %q", domain.InvalidWebhookEventError, event)
+		}
+	}
+
+	var output []string
+	for _, event := range domain.WebhookEvents {
+		if slices.Contains(events, event) {
+			output = append(output, event)
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+)
+
+// webhookMaxRetry is how many times a failed delivery is retried, asynq backs off exponentially between attempts.
+const webhookMaxRetry = 8
+
+type webhookService struct {
+	repo   repo.Querier
+	asynq  *asynq.Client
+	sender domain.WebhookSender
+}
+
+func NewWebhookService(repo repo.Querier, asynq *asynq.Client, sender domain.WebhookSender) domain.WebhookService {
+	return &webhookService{
+		repo:   repo,
+		asynq:  asynq,
+		sender: sender,
+	}
+}
+
+func (s *webhookService) ListWebhooks(ctx context.Context, userId int32) ([]domain.WebhookOutput, error) {
+	webhooks, err := s.repo.ListWebhooks(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get webhooks: %w", err)
+	}
+
+	return domain.ToWebhookOutputList(webhooks), nil
+}
+
+func (s *webhookService) GetWebhookByID(ctx context.Context, id int64, userId int32) (*domain.WebhookOutput, error) {
+	webhook, err := s.repo.GetWebhookByID(ctx, repo.GetWebhookByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get webhook by id: %w", err)
+	}
+
+	return domain.ToWebhookOutput(&webhook), nil
+}
+
+func (s *webhookService) CreateWebhook(ctx context.Context, input domain.CreateWebhookInput) (*domain.WebhookOutput, error) {
+	events, err := normalizeWebhookEvents(input.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sender.ValidateUrl(ctx, input.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.repo.CreateWebhook(ctx, repo.CreateWebhookParams{
+		UserID: input.UserID,
+		Url:    input.Url,
+		Secret: input.Secret,
+		Events: events,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create webhook: %w", err)
+	}
+
+	return domain.ToWebhookOutput(&webhook), nil
+}
+
+// UpdateWebhook replaces webhook settings, empty secret keeps the current one.
+func (s *webhookService) UpdateWebhook(ctx context.Context, input domain.UpdateWebhookInput) (*domain.WebhookOutput, error) {
+	events, err := normalizeWebhookEvents(input.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sender.ValidateUrl(ctx, input.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Secret == "" {
+		dbWebhook, err := s.repo.GetWebhookByID(ctx, repo.GetWebhookByIDParams{
+			ID:     input.ID,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get webhook by id: %w", err)
+		}
+		input.Secret = dbWebhook.Secret
+	}
+
+	webhook, err := s.repo.UpdateWebhookByID(ctx, repo.UpdateWebhookByIDParams{
+		ID:       input.ID,
+		UserID:   input.UserID,
+		Url:      input.Url,
+		Secret:   input.Secret,
+		Events:   events,
+		IsActive: input.IsActive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't update webhook: %w", err)
+	}
+
+	return domain.ToWebhookOutput(&webhook), nil
+}
+
+func (s *webhookService) DeleteWebhookByID(ctx context.Context, id int64, userId int32) error {
+	err := s.repo.DeleteWebhookByID(ctx, repo.DeleteWebhookByIDParams{
+		ID:     id,
+		UserID: userId,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't delete webhook by id: %w", err)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries returns last deliveries of webhook, newest first.
+func (s *webhookService) ListWebhookDeliveries(ctx context.Context, webhookId int64, userId int32) ([]domain.WebhookDeliveryOutput, error) {
+	_, err := s.GetWebhookByID(ctx, webhookId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.repo.ListWebhookDeliveriesByWebhookID(ctx, int32(webhookId))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get webhook deliveries: %w", err)
+	}
+
+	return domain.ToWebhookDeliveryOutputList(deliveries), nil
+}
+
+// DispatchEvent queues delivery of event to every active webhook of user subscribed to it.
+// Failures are only logged, a broken webhook never fails the change that triggered it.
+func (s *webhookService) DispatchEvent(ctx context.Context, userId int32, event string, data any) {
+	err := s.dispatchEventInternal(ctx, userId, event, data)
+	if err != nil {
+		slog.Error("failed to dispatch webhook event", "event", event, "user_id", userId, "error", err)
+	}
+}
+
+// DeliverWebhook makes one attempt of delivery, returned error makes asynq retry it until webhookMaxRetry is reached.
+func (s *webhookService) DeliverWebhook(ctx context.Context, payload domain.WebhookDeliveryPayload) error {
+	delivery, err := s.repo.GetWebhookDeliveryForSendingByID(ctx, payload.DeliveryID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// webhook was removed together with its deliveries
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get webhook delivery by id: %w", err)
+	}
+
+	if !delivery.IsActive {
+		return s.recordWebhookAttemptInternal(ctx, delivery.ID, repo.WebhookDeliveryStatusFailed, 0, errors.New("webhook is disabled"))
+	}
+
+	statusCode, sendErr := s.sender.Send(ctx, domain.WebhookRequest{
+		Url:        delivery.Url,
+		Secret:     delivery.Secret,
+		Event:      delivery.Event,
+		DeliveryID: delivery.ID,
+		Body:       delivery.Payload,
+	})
+	if sendErr == nil {
+		return s.recordWebhookAttemptInternal(ctx, delivery.ID, repo.WebhookDeliveryStatusSucceeded, statusCode, nil)
+	}
+
+	status := repo.WebhookDeliveryStatusPending
+	if isLastWebhookAttempt(ctx) {
+		status = repo.WebhookDeliveryStatusFailed
+	}
+
+	if err = s.recordWebhookAttemptInternal(ctx, delivery.ID, status, statusCode, sendErr); err != nil {
+		return errors.Join(sendErr, err)
+	}
+
+	return sendErr
+}
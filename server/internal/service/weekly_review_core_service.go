@@ -0,0 +1,246 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// mostRescheduledTasksLimit is the number of tasks listed under most rescheduled in a review.
+const mostRescheduledTasksLimit = 5
+
+// weeklyReviewSummary is stored as JSON, so a review keeps showing the week as it was when it was generated.
+type weeklyReviewSummary struct {
+	TotalTasks           int32              `json:"total_tasks"`
+	CompletedTasks       int32              `json:"completed_tasks"`
+	PlannedMinutes       int32              `json:"planned_minutes"`
+	CompletedMinutes     int32              `json:"completed_minutes"`
+	Goals                []weeklyReviewGoal `json:"goals"`
+	OverdueTasks         []weeklyReviewTask `json:"overdue_tasks"`
+	MostRescheduledTasks []weeklyReviewTask `json:"most_rescheduled_tasks"`
+}
+
+type weeklyReviewGoal struct {
+	GoalID         int64  `json:"goal_id"`
+	Title          string `json:"title"`
+	TotalTasks     int32  `json:"total_tasks"`
+	CompletedTasks int32  `json:"completed_tasks"`
+}
+
+type weeklyReviewTask struct {
+	TaskID          int64  `json:"task_id"`
+	GoalID          int32  `json:"goal_id"`
+	Title           string `json:"title"`
+	ScheduledDate   string `json:"scheduled_date"`
+	RescheduleCount int32  `json:"reschedule_count"`
+}
+
+func (s *weeklyReviewService) generateWeeklyReviewInternal(ctx context.Context, userId int32, weekStart time.Time) (*domain.WeeklyReviewOutput, error) {
+	afterDate := pgtype.Date{
+		Time:  weekStart,
+		Valid: true,
+	}
+	beforeDate := pgtype.Date{
+		Time:  weekStart.AddDate(0, 0, 6),
+		Valid: true,
+	}
+
+	goals, err := s.repo.ListGoalStatisticsByDateRange(ctx, repo.ListGoalStatisticsByDateRangeParams{
+		AfterDate:  afterDate,
+		BeforeDate: beforeDate,
+		UserID:     userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get goal statistics by date range: %w", err)
+	}
+
+	tasks, err := s.repo.ListTasksByDateRange(ctx, repo.ListTasksByDateRangeParams{
+		ScheduledDate:   afterDate,
+		ScheduledDate_2: beforeDate,
+		UserID:          userId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tasks by date range: %w", err)
+	}
+
+	summary, err := json.Marshal(toWeeklyReviewSummary(goals, tasks))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't encode weekly review summary: %w", err)
+	}
+
+	review, err := s.repo.UpsertWeeklyReview(ctx, repo.UpsertWeeklyReviewParams{
+		UserID:    userId,
+		WeekStart: afterDate,
+		Summary:   summary,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't save weekly review: %w", err)
+	}
+
+	return toWeeklyReviewOutput(review)
+}
+
+// toWeeklyReviewSummary counts every scheduled task of week, overdue ones are those left open by the end of it.
+func toWeeklyReviewSummary(goals []repo.ListGoalStatisticsByDateRangeRow, tasks []repo.Task) weeklyReviewSummary {
+	summary := weeklyReviewSummary{
+		Goals:                []weeklyReviewGoal{},
+		OverdueTasks:         []weeklyReviewTask{},
+		MostRescheduledTasks: []weeklyReviewTask{},
+	}
+
+	for _, goal := range goals {
+		if goal.TotalTasks == 0 {
+			continue
+		}
+
+		summary.Goals = append(summary.Goals, weeklyReviewGoal{
+			GoalID:         goal.GoalID,
+			Title:          goal.Title,
+			TotalTasks:     goal.TotalTasks,
+			CompletedTasks: goal.CompletedTasks,
+		})
+	}
+
+	var rescheduled []weeklyReviewTask
+	for _, task := range tasks {
+		summary.TotalTasks++
+		summary.PlannedMinutes += task.DurationMinutes.Int32
+
+		if task.IsDone {
+			summary.CompletedTasks++
+			summary.CompletedMinutes += task.DurationMinutes.Int32
+		} else {
+			summary.OverdueTasks = append(summary.OverdueTasks, toWeeklyReviewTask(task))
+		}
+
+		if task.RescheduleCount > 0 {
+			rescheduled = append(rescheduled, toWeeklyReviewTask(task))
+		}
+	}
+
+	sort.SliceStable(rescheduled, func(i, j int) bool {
+		return rescheduled[i].RescheduleCount > rescheduled[j].RescheduleCount
+	})
+	if len(rescheduled) > mostRescheduledTasksLimit {
+		rescheduled = rescheduled[:mostRescheduledTasksLimit]
+	}
+	summary.MostRescheduledTasks = append(summary.MostRescheduledTasks, rescheduled...)
+
+	return summary
+}
+
+func toWeeklyReviewTask(task repo.Task) weeklyReviewTask {
+	return weeklyReviewTask{
+		TaskID:          task.ID,
+		GoalID:          task.GoalID,
+		Title:           task.Title,
+		ScheduledDate:   task.ScheduledDate.Time.Format(time.DateOnly),
+		RescheduleCount: task.RescheduleCount,
+	}
+}
+
+func toWeeklyReviewOutput(review repo.WeeklyReview) (*domain.WeeklyReviewOutput, error) {
+	var summary weeklyReviewSummary
+	if err := json.Unmarshal(review.Summary, &summary); err != nil {
+		return nil, fmt.Errorf("couldn't decode weekly review summary: %w", err)
+	}
+
+	output := &domain.WeeklyReviewOutput{
+		ID:                   review.ID,
+		UserID:               review.UserID,
+		WeekStart:            review.WeekStart.Time,
+		WeekEnd:              review.WeekStart.Time.AddDate(0, 0, 6),
+		TotalTasks:           summary.TotalTasks,
+		CompletedTasks:       summary.CompletedTasks,
+		PlannedMinutes:       summary.PlannedMinutes,
+		CompletedMinutes:     summary.CompletedMinutes,
+		Goals:                make([]domain.ReviewGoalOutput, len(summary.Goals)),
+		OverdueTasks:         make([]domain.ReviewTaskOutput, len(summary.OverdueTasks)),
+		MostRescheduledTasks: make([]domain.ReviewTaskOutput, len(summary.MostRescheduledTasks)),
+		CreatedAt:            review.CreatedAt.Time,
+	}
+
+	for i, goal := range summary.Goals {
+		output.Goals[i] = domain.ReviewGoalOutput{
+			GoalID:         goal.GoalID,
+			Title:          goal.Title,
+			TotalTasks:     goal.TotalTasks,
+			CompletedTasks: goal.CompletedTasks,
+		}
+	}
+	for i, task := range summary.OverdueTasks {
+		output.OverdueTasks[i] = toReviewTaskOutput(task)
+	}
+	for i, task := range summary.MostRescheduledTasks {
+		output.MostRescheduledTasks[i] = toReviewTaskOutput(task)
+	}
+
+	return output, nil
+}
+
+func toReviewTaskOutput(task weeklyReviewTask) domain.ReviewTaskOutput {
+	scheduledDate, _ := time.Parse(time.DateOnly, task.ScheduledDate)
+
+	return domain.ReviewTaskOutput{
+		TaskID:          task.TaskID,
+		GoalID:          task.GoalID,
+		Title:           task.Title,
+		ScheduledDate:   scheduledDate,
+		RescheduleCount: task.RescheduleCount,
+	}
+}
+
+func renderWeeklyReviewMarkdown(review *domain.WeeklyReviewOutput) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "# Weekly review %s – %s\n\n", review.WeekStart.Format(time.DateOnly), review.WeekEnd.Format(time.DateOnly))
+	fmt.Fprintf(&buf, "- Completed tasks: %d of %d\n", review.CompletedTasks, review.TotalTasks)
+	fmt.Fprintf(&buf, "- Minutes done: %d of %d planned\n", review.CompletedMinutes, review.PlannedMinutes)
+
+	buf.WriteString("\n## Completed tasks per goal\n\n")
+	if len(review.Goals) == 0 {
+		buf.WriteString("No tasks were scheduled.\n")
+	} else {
+		buf.WriteString("| Goal | Completed | Total |\n| --- | ---: | ---: |\n")
+		for _, goal := range review.Goals {
+			fmt.Fprintf(&buf, "| %s | %d | %d |\n", escapeMarkdown(goal.Title), goal.CompletedTasks, goal.TotalTasks)
+		}
+	}
+
+	buf.WriteString("\n## Overdue tasks\n\n")
+	if len(review.OverdueTasks) == 0 {
+		buf.WriteString("Nothing was left open.\n")
+	}
+	for _, task := range review.OverdueTasks {
+		fmt.Fprintf(&buf, "- %s (%s)\n", escapeMarkdown(task.Title), task.ScheduledDate.Format(time.DateOnly))
+	}
+
+	buf.WriteString("\n## Most rescheduled tasks\n\n")
+	if len(review.MostRescheduledTasks) == 0 {
+		buf.WriteString("Nothing was rescheduled.\n")
+	}
+	for _, task := range review.MostRescheduledTasks {
+		fmt.Fprintf(&buf, "- %s, rescheduled %d times\n", escapeMarkdown(task.Title), task.RescheduleCount)
+	}
+
+	return buf.Bytes()
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, `*`, `\*`, `_`, `\_`, "`", "\\`", `[`, `\[`, `]`, `\]`, "\n", " ")
+
+func escapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// startOfWeek returns monday of week of date.
+func startOfWeek(date time.Time) time.Time {
+	return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	repo "github.com/ali-nur31/mile-do/internal/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type weeklyReviewService struct {
+	repo        repo.Querier
+	userService domain.UserService
+}
+
+func NewWeeklyReviewService(repo repo.Querier, userService domain.UserService) domain.WeeklyReviewService {
+	return &weeklyReviewService{
+		repo:        repo,
+		userService: userService,
+	}
+}
+
+// GetWeeklyReview returns stored review of week starting on monday weekStart, last week by default.
+// A finished week without stored review, e.g. one before user signed up, is built on request.
+func (s *weeklyReviewService) GetWeeklyReview(ctx context.Context, userId int32, weekStart time.Time) (*domain.WeeklyReviewOutput, error) {
+	loc, err := s.userService.GetUserLocation(ctx, nil, int64(userId))
+	if err != nil {
+		return nil, err
+	}
+
+	currentWeekStart := startOfWeek(dateInLocation(time.Now(), loc))
+	if weekStart.IsZero() {
+		weekStart = currentWeekStart.AddDate(0, 0, -7)
+	}
+
+	if weekStart.Weekday() != time.Monday {
+		return nil, fmt.Errorf("%w: week must start on monday", domain.InvalidReviewWeekError)
+	}
+	if !weekStart.Before(currentWeekStart) {
+		return nil, fmt.Errorf("%w: week hasn't ended yet", domain.InvalidReviewWeekError)
+	}
+
+	review, err := s.repo.GetWeeklyReviewByWeekStart(ctx, repo.GetWeeklyReviewByWeekStartParams{
+		UserID: userId,
+		WeekStart: pgtype.Date{
+			Time:  weekStart,
+			Valid: true,
+		},
+	})
+	if err == nil {
+		return toWeeklyReviewOutput(review)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("couldn't get weekly review by week start: %w", err)
+	}
+
+	return s.generateWeeklyReviewInternal(ctx, userId, weekStart)
+}
+
+func (s *weeklyReviewService) GetWeeklyReviewMarkdown(ctx context.Context, userId int32, weekStart time.Time) ([]byte, error) {
+	review, err := s.GetWeeklyReview(ctx, userId, weekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return renderWeeklyReviewMarkdown(review), nil
+}
+
+// GenerateWeeklyReviews stores review of last finished week in time zone of every user who doesn't have one yet.
+func (s *weeklyReviewService) GenerateWeeklyReviews(ctx context.Context) (int64, error) {
+	users, err := s.repo.ListUserTimeZones(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get users time zones: %w", err)
+	}
+
+	var generated int64
+	for _, user := range users {
+		loc, err := time.LoadLocation(user.TimeZone)
+		if err != nil {
+			loc = time.UTC
+		}
+
+		weekStart := startOfWeek(dateInLocation(time.Now(), loc)).AddDate(0, 0, -7)
+
+		_, err = s.repo.GetWeeklyReviewByWeekStart(ctx, repo.GetWeeklyReviewByWeekStartParams{
+			UserID: int32(user.ID),
+			WeekStart: pgtype.Date{
+				Time:  weekStart,
+				Valid: true,
+			},
+		})
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			slog.Error("failed to get weekly review", "user_id", user.ID, "error", err)
+			continue
+		}
+
+		_, err = s.generateWeeklyReviewInternal(ctx, int32(user.ID), weekStart)
+		if err != nil {
+			slog.Error("failed to generate weekly review", "user_id", user.ID, "error", err)
+			continue
+		}
+
+		generated++
+	}
+
+	return generated, nil
+}
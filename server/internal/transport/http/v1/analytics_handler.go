@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/labstack/echo/v4"
+)
+
+type AnalyticsHandler struct {
+	service domain.AnalyticsService
+}
+
+func NewAnalyticsHandler(service domain.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		service: service,
+	}
+}
+
+// GetAnalytics godoc
+// @Summary      get analytics
+// @Description  get completed tasks, planned and completed minutes, completion rate and average reschedule count of scheduled tasks in range, in total, per goal and per goal category
+// @Tags         analytics
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        after_date query string false "first date of range, defaults to 29 days before before_date"
+// @Param        before_date query string false "last date of range, defaults to today in user's time zone"
+// @Success      200  {object}  dto.AnalyticsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /analytics/ [get]
+func (h *AnalyticsHandler) GetAnalytics(c echo.Context) error {
+	var afterDate, beforeDate time.Time
+	var err error
+	if afterDateParam := c.QueryParam("after_date"); afterDateParam != "" {
+		afterDate, err = time.Parse(time.DateOnly, afterDateParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, after_date must be in YYYY-MM-DD format", "error": err.Error()})
+		}
+	}
+
+	if beforeDateParam := c.QueryParam("before_date"); beforeDateParam != "" {
+		beforeDate, err = time.Parse(time.DateOnly, beforeDateParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, before_date must be in YYYY-MM-DD format", "error": err.Error()})
+		}
+	}
+
+	if !afterDate.IsZero() && !beforeDate.IsZero() && afterDate.After(beforeDate) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, after_date must not be later than before_date", "error": "invalid date range"})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	analytics, err := h.service.GetAnalytics(c.Request().Context(), domain.GetAnalyticsInput{
+		UserID:     int32(claims.ID),
+		AfterDate:  afterDate,
+		BeforeDate: beforeDate,
+	})
+	if err != nil {
+		slog.Error("failed on getting analytics", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToAnalyticsResponse(analytics))
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+type CalendarHandler struct {
+	service domain.CalendarService
+}
+
+func NewCalendarHandler(service domain.CalendarService) *CalendarHandler {
+	return &CalendarHandler{
+		service: service,
+	}
+}
+
+// GetCalendarFeed godoc
+// @Summary      get iCalendar feed by :token
+// @Description  get scheduled tasks and recurring tasks templates of user as iCalendar subscription, authorized by secret token in url
+// @Tags         calendar
+// @Produce      text/calendar
+// @Param        token path string true "Calendar Token"
+// @Success      200  {string}  string "iCalendar feed"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Router       /calendar/{token}.ics [get]
+func (h *CalendarHandler) GetCalendarFeed(c echo.Context) error {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	feed, err := h.service.GetCalendarFeed(c.Request().Context(), token)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find calendar with provided token", "error": err.Error()})
+	}
+
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8", feed)
+}
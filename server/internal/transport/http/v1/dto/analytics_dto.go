@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type TaskStatisticsData struct {
+	TotalTasks             int32   `json:"total_tasks"`
+	CompletedTasks         int32   `json:"completed_tasks"`
+	PlannedMinutes         int32   `json:"planned_minutes"`
+	CompletedMinutes       int32   `json:"completed_minutes"`
+	CompletionRate         float64 `json:"completion_rate"`
+	AverageRescheduleCount float64 `json:"average_reschedule_count"`
+}
+
+type GoalStatisticsData struct {
+	GoalID       int64  `json:"goal_id"`
+	Title        string `json:"title"`
+	CategoryType string `json:"category_type"`
+	TaskStatisticsData
+}
+
+type CategoryStatisticsData struct {
+	CategoryType string `json:"category_type"`
+	TaskStatisticsData
+}
+
+type AnalyticsResponse struct {
+	AfterDate  string                   `json:"after_date"`
+	BeforeDate string                   `json:"before_date"`
+	Total      TaskStatisticsData       `json:"total"`
+	Goals      []GoalStatisticsData     `json:"goals"`
+	Categories []CategoryStatisticsData `json:"categories"`
+}
+
+func ToAnalyticsResponse(output *domain.AnalyticsOutput) AnalyticsResponse {
+	goals := make([]GoalStatisticsData, len(output.Goals))
+	for i, goal := range output.Goals {
+		goals[i] = GoalStatisticsData{
+			GoalID:             goal.GoalID,
+			Title:              goal.Title,
+			CategoryType:       goal.CategoryType,
+			TaskStatisticsData: toTaskStatisticsData(goal.Statistics),
+		}
+	}
+
+	categories := make([]CategoryStatisticsData, len(output.Categories))
+	for i, category := range output.Categories {
+		categories[i] = CategoryStatisticsData{
+			CategoryType:       category.CategoryType,
+			TaskStatisticsData: toTaskStatisticsData(category.Statistics),
+		}
+	}
+
+	return AnalyticsResponse{
+		AfterDate:  output.AfterDate.Format(time.DateOnly),
+		BeforeDate: output.BeforeDate.Format(time.DateOnly),
+		Total:      toTaskStatisticsData(output.Total),
+		Goals:      goals,
+		Categories: categories,
+	}
+}
+
+func toTaskStatisticsData(output domain.TaskStatisticsOutput) TaskStatisticsData {
+	return TaskStatisticsData{
+		TotalTasks:             output.TotalTasks,
+		CompletedTasks:         output.CompletedTasks,
+		PlannedMinutes:         output.PlannedMinutes,
+		CompletedMinutes:       output.CompletedMinutes,
+		CompletionRate:         output.CompletionRate,
+		AverageRescheduleCount: output.AverageRescheduleCount,
+	}
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "github.com/ali-nur31/mile-do/internal/domain"
+
+type ExportJobResponse struct {
+	ID         int64  `json:"id"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	CreatedAt  string `json:"created_at"`
+	FinishedAt string `json:"finished_at,omitempty"`
+}
+
+func ToExportJobResponse(job *domain.ExportJobOutput) ExportJobResponse {
+	response := ExportJobResponse{
+		ID:        job.ID,
+		Status:    job.Status,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt.String(),
+	}
+
+	if !job.FinishedAt.IsZero() {
+		response.FinishedAt = job.FinishedAt.String()
+	}
+
+	return response
+}
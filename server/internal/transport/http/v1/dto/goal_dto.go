@@ -26,6 +26,7 @@ type GoalResponse struct {
 	CategoryType string `json:"category_type"`
 	IsArchived   bool   `json:"is_archived"`
 	CreatedAt    string `json:"created_at"`
+	Role         string `json:"role"`
 }
 
 func ToGoalResponse(output *domain.GoalOutput) GoalResponse {
@@ -37,16 +38,19 @@ func ToGoalResponse(output *domain.GoalOutput) GoalResponse {
 		CategoryType: output.CategoryType,
 		IsArchived:   output.IsArchived,
 		CreatedAt:    output.CreatedAt.String(),
+		Role:         output.Role,
 	}
 }
 
 type GoalData struct {
 	ID           int64  `json:"id"`
+	OwnerID      int32  `json:"owner_id"`
 	Title        string `json:"title"`
 	Color        string `json:"color"`
 	CategoryType string `json:"category_type"`
 	IsArchived   bool   `json:"is_archived"`
 	CreatedAt    string `json:"created_at"`
+	Role         string `json:"role"`
 }
 
 type ListGoalsResponse struct {
@@ -67,11 +71,13 @@ func ToListGoalsResponse(goals []domain.GoalOutput) ListGoalsResponse {
 	for index, goal := range goals {
 		outGoalData[index] = GoalData{
 			ID:           goal.ID,
+			OwnerID:      goal.UserID,
 			Title:        goal.Title,
 			Color:        goal.Color,
 			CategoryType: goal.CategoryType,
 			IsArchived:   goal.IsArchived,
 			CreatedAt:    goal.CreatedAt.String(),
+			Role:         goal.Role,
 		}
 	}
 
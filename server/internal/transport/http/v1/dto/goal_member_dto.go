@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type InviteGoalMemberRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+	Role  string `json:"role" validate:"required,oneof=editor viewer"`
+}
+
+type UpdateGoalMemberRequest struct {
+	Role string `json:"role" validate:"required,oneof=editor viewer"`
+}
+
+type GoalMemberResponse struct {
+	UserID    int32  `json:"user_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+}
+
+type ListGoalMembersResponse struct {
+	GoalID int64                `json:"goal_id"`
+	Data   []GoalMemberResponse `json:"data"`
+}
+
+func ToListGoalMembersResponse(goalId int64, members []domain.GoalMemberOutput) ListGoalMembersResponse {
+	data := make([]GoalMemberResponse, len(members))
+	for i, member := range members {
+		data[i] = GoalMemberResponse{
+			UserID:    member.UserID,
+			Email:     member.Email,
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return ListGoalMembersResponse{
+		GoalID: goalId,
+		Data:   data,
+	}
+}
+
+type GoalInvitationResponse struct {
+	ID             int64  `json:"id"`
+	GoalID         int32  `json:"goal_id"`
+	GoalTitle      string `json:"goal_title,omitempty"`
+	Email          string `json:"email,omitempty"`
+	Role           string `json:"role"`
+	InvitedBy      int32  `json:"invited_by,omitempty"`
+	InvitedByEmail string `json:"invited_by_email,omitempty"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type ListGoalInvitationsResponse struct {
+	Data []GoalInvitationResponse `json:"data"`
+}
+
+func ToGoalInvitationResponse(invitation *domain.GoalInvitationOutput) GoalInvitationResponse {
+	return GoalInvitationResponse{
+		ID:             invitation.ID,
+		GoalID:         invitation.GoalID,
+		GoalTitle:      invitation.GoalTitle,
+		Email:          invitation.Email,
+		Role:           invitation.Role,
+		InvitedBy:      invitation.InvitedBy,
+		InvitedByEmail: invitation.InvitedByEmail,
+		CreatedAt:      invitation.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToListGoalInvitationsResponse(invitations []domain.GoalInvitationOutput) ListGoalInvitationsResponse {
+	data := make([]GoalInvitationResponse, len(invitations))
+	for i := range invitations {
+		data[i] = ToGoalInvitationResponse(&invitations[i])
+	}
+
+	return ListGoalInvitationsResponse{
+		Data: data,
+	}
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type HabitCheckInRequest struct {
+	Date string `json:"date" validate:"required,datetime=2006-01-02"`
+}
+
+type HabitDayData struct {
+	Date   string `json:"date"`
+	Status string `json:"status"`
+	TaskID int64  `json:"task_id,omitempty"`
+}
+
+type HabitResponse struct {
+	TemplateID     int64          `json:"template_id"`
+	GoalID         int32          `json:"goal_id"`
+	Title          string         `json:"title"`
+	RecurrenceMode string         `json:"recurrence_mode"`
+	AfterDate      string         `json:"after_date"`
+	BeforeDate     string         `json:"before_date"`
+	DoneDays       int32          `json:"done_days"`
+	MissedDays     int32          `json:"missed_days"`
+	CompletionRate float64        `json:"completion_rate"`
+	Days           []HabitDayData `json:"days"`
+}
+
+type ListHabitsResponse struct {
+	UserID int32           `json:"user_id"`
+	Data   []HabitResponse `json:"data"`
+}
+
+func ToHabitResponse(output *domain.HabitOutput) HabitResponse {
+	days := make([]HabitDayData, len(output.Days))
+	for i, day := range output.Days {
+		days[i] = HabitDayData{
+			Date:   day.Date.Format(time.DateOnly),
+			Status: day.Status,
+			TaskID: day.TaskID,
+		}
+	}
+
+	return HabitResponse{
+		TemplateID:     output.TemplateID,
+		GoalID:         output.GoalID,
+		Title:          output.Title,
+		RecurrenceMode: output.RecurrenceMode,
+		AfterDate:      output.AfterDate.Format(time.DateOnly),
+		BeforeDate:     output.BeforeDate.Format(time.DateOnly),
+		DoneDays:       output.DoneDays,
+		MissedDays:     output.MissedDays,
+		CompletionRate: output.CompletionRate,
+		Days:           days,
+	}
+}
+
+func ToListHabitsResponse(userId int32, outputs []domain.HabitOutput) ListHabitsResponse {
+	habits := make([]HabitResponse, len(outputs))
+	for i := range outputs {
+		habits[i] = ToHabitResponse(&outputs[i])
+	}
+
+	return ListHabitsResponse{
+		UserID: userId,
+		Data:   habits,
+	}
+}
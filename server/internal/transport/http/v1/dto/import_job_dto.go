@@ -0,0 +1,78 @@
+package dto
+
+import "github.com/ali-nur31/mile-do/internal/domain"
+
+type ImportJobResponse struct {
+	ID             int64    `json:"id"`
+	GoalID         int32    `json:"goal_id"`
+	Source         string   `json:"source"`
+	Status         string   `json:"status"`
+	TotalItems     int32    `json:"total_items"`
+	ProcessedItems int32    `json:"processed_items"`
+	FailedItems    int32    `json:"failed_items"`
+	ItemErrors     []string `json:"item_errors"`
+	Error          string   `json:"error,omitempty"`
+	CreatedAt      string   `json:"created_at"`
+	FinishedAt     string   `json:"finished_at,omitempty"`
+}
+
+func ToImportJobResponse(job *domain.ImportJobOutput) ImportJobResponse {
+	response := ImportJobResponse{
+		ID:             job.ID,
+		GoalID:         job.GoalID,
+		Source:         job.Source,
+		Status:         job.Status,
+		TotalItems:     job.TotalItems,
+		ProcessedItems: job.ProcessedItems,
+		FailedItems:    job.FailedItems,
+		ItemErrors:     job.ItemErrors,
+		Error:          job.Error,
+		CreatedAt:      job.CreatedAt.String(),
+	}
+
+	if !job.FinishedAt.IsZero() {
+		response.FinishedAt = job.FinishedAt.String()
+	}
+
+	return response
+}
+
+type ImportRowErrorResponse struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+type ImportCsvResponse struct {
+	Source            string                   `json:"source"`
+	DryRun            bool                     `json:"dry_run"`
+	TotalRows         int                      `json:"total_rows"`
+	ImportedTasks     int                      `json:"imported_tasks"`
+	ImportedTemplates int                      `json:"imported_templates"`
+	NewGoals          []string                 `json:"new_goals"`
+	RowErrors         []ImportRowErrorResponse `json:"row_errors"`
+}
+
+func ToImportCsvResponse(output *domain.ImportCsvOutput) ImportCsvResponse {
+	rowErrors := make([]ImportRowErrorResponse, len(output.RowErrors))
+	for i, rowError := range output.RowErrors {
+		rowErrors[i] = ImportRowErrorResponse{
+			Row:   rowError.Row,
+			Error: rowError.Error,
+		}
+	}
+
+	newGoals := output.NewGoals
+	if newGoals == nil {
+		newGoals = []string{}
+	}
+
+	return ImportCsvResponse{
+		Source:            output.Source,
+		DryRun:            output.DryRun,
+		TotalRows:         output.TotalRows,
+		ImportedTasks:     output.ImportedTasks,
+		ImportedTemplates: output.ImportedTemplates,
+		NewGoals:          newGoals,
+		RowErrors:         rowErrors,
+	}
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type NotificationResponse struct {
+	ID        int64  `json:"id"`
+	TaskID    int32  `json:"task_id,omitempty"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	IsRead    bool   `json:"is_read"`
+	ReadAt    string `json:"read_at,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type ListNotificationsResponse struct {
+	UserID int32                  `json:"user_id"`
+	Data   []NotificationResponse `json:"data"`
+}
+
+func ToNotificationResponse(notification *domain.NotificationOutput) NotificationResponse {
+	response := NotificationResponse{
+		ID:        notification.ID,
+		TaskID:    notification.TaskID,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		IsRead:    !notification.ReadAt.IsZero(),
+		CreatedAt: notification.CreatedAt.Format(time.RFC3339),
+	}
+
+	if response.IsRead {
+		response.ReadAt = notification.ReadAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+func ToListNotificationsResponse(userId int32, notifications []domain.NotificationOutput) ListNotificationsResponse {
+	data := make([]NotificationResponse, len(notifications))
+	for i := range notifications {
+		data[i] = ToNotificationResponse(&notifications[i])
+	}
+
+	return ListNotificationsResponse{
+		UserID: userId,
+		Data:   data,
+	}
+}
@@ -1,56 +1,86 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/ali-nur31/mile-do/internal/domain"
 )
 
 type UpdateRecurringTasksTemplateRequest struct {
-	GoalID            int32  `json:"goal_id" validate:"required,gte=0"`
-	Title             string `json:"title" validate:"required,min=3,max=256"`
-	ScheduledDatetime string `json:"scheduled_datetime" validate:"required"`
-	ScheduledEndTime  string `json:"scheduled_end_time" validate:"omitempty"`
-	HasTime           bool   `json:"has_time" validate:"required"`
-	RecurrenceRrule   string `json:"recurrence_rrule" validate:"required,min=3"`
+	GoalID                 int32    `json:"goal_id" validate:"required,gte=0"`
+	Title                  string   `json:"title" validate:"required,min=3,max=256"`
+	ScheduledDatetime      string   `json:"scheduled_datetime" validate:"required"`
+	ScheduledEndTime       string   `json:"scheduled_end_time" validate:"omitempty"`
+	HasTime                bool     `json:"has_time" validate:"required"`
+	RecurrenceRrule        string   `json:"recurrence_rrule" validate:"required_unless=RecurrenceMode after_completion,omitempty,min=3"`
+	RecurrenceMode         string   `json:"recurrence_mode" validate:"omitempty,oneof=calendar after_completion"`
+	RecurrenceIntervalDays int32    `json:"recurrence_interval_days" validate:"required_if=RecurrenceMode after_completion,omitempty,gt=0"`
+	Priority               string   `json:"priority" validate:"omitempty,oneof=none low medium high"`
+	Exdates                []string `json:"exdates" validate:"omitempty,dive,datetime=2006-01-02"`
+	Rdates                 []string `json:"rdates" validate:"omitempty,dive,datetime=2006-01-02"`
+	AssigneeIDs            []int32  `json:"assignee_ids" validate:"omitempty,unique,dive,gt=0"`
+	TagIDs                 []int32  `json:"tag_ids" validate:"omitempty,dive,gt=0"`
 }
 
 type RecurringTasksTemplateResponse struct {
-	ID                int64  `json:"id"`
-	UserID            int32  `json:"user_id"`
-	GoalID            int32  `json:"goal_id"`
-	Title             string `json:"title"`
-	ScheduledDatetime string `json:"scheduled_datetime"`
-	HasTime           bool   `json:"has_time"`
-	DurationMinutes   int32  `json:"duration_minutes"`
-	RecurrenceRrule   string `json:"recurrence_rrule"`
-	LastGeneratedDate string `json:"last_generated_date"`
-	CreatedAt         string `json:"created_at"`
+	ID                     int64    `json:"id"`
+	UserID                 int32    `json:"user_id"`
+	GoalID                 int32    `json:"goal_id"`
+	Title                  string   `json:"title"`
+	ScheduledDatetime      string   `json:"scheduled_datetime"`
+	HasTime                bool     `json:"has_time"`
+	DurationMinutes        int32    `json:"duration_minutes"`
+	RecurrenceRrule        string   `json:"recurrence_rrule"`
+	RecurrenceMode         string   `json:"recurrence_mode"`
+	RecurrenceIntervalDays int32    `json:"recurrence_interval_days"`
+	Priority               string   `json:"priority"`
+	Exdates                []string `json:"exdates"`
+	Rdates                 []string `json:"rdates"`
+	LastGeneratedDate      string   `json:"last_generated_date"`
+	AssigneeIDs            []int32  `json:"assignee_ids"`
+	TagIDs                 []int32  `json:"tag_ids"`
+	CreatedAt              string   `json:"created_at"`
 }
 
 func ToRecurringTasksTemplateResponse(template *domain.RecurringTasksTemplateOutput) RecurringTasksTemplateResponse {
 	return RecurringTasksTemplateResponse{
-		ID:                template.ID,
-		UserID:            template.UserID,
-		GoalID:            template.GoalID,
-		Title:             template.Title,
-		ScheduledDatetime: template.ScheduledDatetime.String(),
-		HasTime:           template.HasTime,
-		DurationMinutes:   template.DurationMinutes,
-		RecurrenceRrule:   template.RecurrenceRrule,
-		LastGeneratedDate: template.LastGeneratedDate.String(),
-		CreatedAt:         template.CreatedAt.String(),
+		ID:                     template.ID,
+		UserID:                 template.UserID,
+		GoalID:                 template.GoalID,
+		Title:                  template.Title,
+		ScheduledDatetime:      template.ScheduledDatetime.String(),
+		HasTime:                template.HasTime,
+		DurationMinutes:        template.DurationMinutes,
+		RecurrenceRrule:        template.RecurrenceRrule,
+		RecurrenceMode:         template.RecurrenceMode,
+		RecurrenceIntervalDays: template.RecurrenceIntervalDays,
+		Priority:               template.Priority,
+		Exdates:                toDateStrings(template.Exdates),
+		Rdates:                 toDateStrings(template.Rdates),
+		LastGeneratedDate:      template.LastGeneratedDate.String(),
+		AssigneeIDs:            template.AssigneeIDs,
+		TagIDs:                 template.TagIDs,
+		CreatedAt:              template.CreatedAt.String(),
 	}
 }
 
 type RecurringTasksTemplateData struct {
-	ID                int64  `json:"id"`
-	GoalID            int32  `json:"goal_id"`
-	Title             string `json:"title"`
-	ScheduledDatetime string `json:"scheduled_datetime"`
-	HasTime           bool   `json:"has_time"`
-	DurationMinutes   int32  `json:"duration_minutes"`
-	RecurrenceRrule   string `json:"recurrence_rrule"`
-	LastGeneratedDate string `json:"last_generated_date"`
-	CreatedAt         string `json:"created_at"`
+	ID                     int64    `json:"id"`
+	GoalID                 int32    `json:"goal_id"`
+	Title                  string   `json:"title"`
+	ScheduledDatetime      string   `json:"scheduled_datetime"`
+	HasTime                bool     `json:"has_time"`
+	DurationMinutes        int32    `json:"duration_minutes"`
+	RecurrenceRrule        string   `json:"recurrence_rrule"`
+	RecurrenceMode         string   `json:"recurrence_mode"`
+	RecurrenceIntervalDays int32    `json:"recurrence_interval_days"`
+	Priority               string   `json:"priority"`
+	Exdates                []string `json:"exdates"`
+	Rdates                 []string `json:"rdates"`
+	LastGeneratedDate      string   `json:"last_generated_date"`
+	AssigneeIDs            []int32  `json:"assignee_ids"`
+	TagIDs                 []int32  `json:"tag_ids"`
+	CreatedAt              string   `json:"created_at"`
 }
 
 type ListRecurringTasksTemplatesResponse struct {
@@ -63,15 +93,22 @@ func ToListRecurringTasksTemplatesResponse(output []domain.RecurringTasksTemplat
 
 	for index, template := range output {
 		outTemplatesData[index] = RecurringTasksTemplateData{
-			ID:                template.ID,
-			GoalID:            template.GoalID,
-			Title:             template.Title,
-			ScheduledDatetime: template.ScheduledDatetime.String(),
-			HasTime:           template.HasTime,
-			DurationMinutes:   template.DurationMinutes,
-			RecurrenceRrule:   template.RecurrenceRrule,
-			LastGeneratedDate: template.LastGeneratedDate.String(),
-			CreatedAt:         template.CreatedAt.String(),
+			ID:                     template.ID,
+			GoalID:                 template.GoalID,
+			Title:                  template.Title,
+			ScheduledDatetime:      template.ScheduledDatetime.String(),
+			HasTime:                template.HasTime,
+			DurationMinutes:        template.DurationMinutes,
+			RecurrenceRrule:        template.RecurrenceRrule,
+			RecurrenceMode:         template.RecurrenceMode,
+			RecurrenceIntervalDays: template.RecurrenceIntervalDays,
+			Priority:               template.Priority,
+			Exdates:                toDateStrings(template.Exdates),
+			Rdates:                 toDateStrings(template.Rdates),
+			LastGeneratedDate:      template.LastGeneratedDate.String(),
+			AssigneeIDs:            template.AssigneeIDs,
+			TagIDs:                 template.TagIDs,
+			CreatedAt:              template.CreatedAt.String(),
 		}
 	}
 
@@ -80,3 +117,11 @@ func ToListRecurringTasksTemplatesResponse(output []domain.RecurringTasksTemplat
 		RecurringTasksTemplateData: outTemplatesData,
 	}
 }
+
+func toDateStrings(dates []time.Time) []string {
+	output := make([]string, len(dates))
+	for i, date := range dates {
+		output[i] = date.Format(time.DateOnly)
+	}
+	return output
+}
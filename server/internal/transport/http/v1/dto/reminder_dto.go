@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type CreateReminderRequest struct {
+	OffsetMinutes int32 `json:"offset_minutes" validate:"gte=0,lte=10080"`
+}
+
+type ReminderResponse struct {
+	ID                  int64  `json:"id"`
+	TaskID              int32  `json:"task_id,omitempty"`
+	RecurringTemplateID int32  `json:"recurring_template_id,omitempty"`
+	OffsetMinutes       int32  `json:"offset_minutes"`
+	CreatedAt           string `json:"created_at"`
+}
+
+type ListRemindersResponse struct {
+	UserID int32              `json:"user_id"`
+	Data   []ReminderResponse `json:"data"`
+}
+
+func ToReminderResponse(reminder *domain.ReminderOutput) ReminderResponse {
+	return ReminderResponse{
+		ID:                  reminder.ID,
+		TaskID:              reminder.TaskID,
+		RecurringTemplateID: reminder.RecurringTemplateID,
+		OffsetMinutes:       reminder.OffsetMinutes,
+		CreatedAt:           reminder.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToListRemindersResponse(userId int32, reminders []domain.ReminderOutput) ListRemindersResponse {
+	data := make([]ReminderResponse, len(reminders))
+	for i := range reminders {
+		data[i] = ToReminderResponse(&reminders[i])
+	}
+
+	return ListRemindersResponse{
+		UserID: userId,
+		Data:   data,
+	}
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type CreateTagRequest struct {
+	Title string `json:"title" validate:"required,min=1,max=64"`
+	Color string `json:"color" validate:"omitempty,hexcolor"`
+}
+
+type UpdateTagRequest struct {
+	Title string `json:"title" validate:"required,min=1,max=64"`
+	Color string `json:"color" validate:"omitempty,hexcolor"`
+}
+
+type TagResponse struct {
+	ID        int64  `json:"id"`
+	UserID    int32  `json:"user_id"`
+	Title     string `json:"title"`
+	Color     string `json:"color"`
+	CreatedAt string `json:"created_at"`
+}
+
+func ToTagResponse(tag *domain.TagOutput) TagResponse {
+	return TagResponse{
+		ID:        tag.ID,
+		UserID:    tag.UserID,
+		Title:     tag.Title,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt.String(),
+	}
+}
+
+type TagData struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+	Color string `json:"color"`
+}
+
+func ToTagDataList(tags []domain.TagOutput) []TagData {
+	tagData := make([]TagData, len(tags))
+	for index, tag := range tags {
+		tagData[index] = TagData{
+			ID:    tag.ID,
+			Title: tag.Title,
+			Color: tag.Color,
+		}
+	}
+	return tagData
+}
+
+type ListTagsResponse struct {
+	Tags []TagData `json:"tags"`
+}
+
+func ToListTagsResponse(tags []domain.TagOutput) ListTagsResponse {
+	return ListTagsResponse{
+		Tags: ToTagDataList(tags),
+	}
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type TaskAttachmentResponse struct {
+	ID          int64  `json:"id"`
+	TaskID      int32  `json:"task_id"`
+	UserID      int32  `json:"user_id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	SizeBytes   int64  `json:"size_bytes"`
+	CreatedAt   string `json:"created_at"`
+}
+
+func ToTaskAttachmentResponse(attachment *domain.TaskAttachmentOutput) TaskAttachmentResponse {
+	return TaskAttachmentResponse{
+		ID:          attachment.ID,
+		TaskID:      attachment.TaskID,
+		UserID:      attachment.UserID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		CreatedAt:   attachment.CreatedAt.String(),
+	}
+}
+
+type ListTaskAttachmentsResponse struct {
+	TaskID      int32                    `json:"task_id"`
+	Attachments []TaskAttachmentResponse `json:"attachments"`
+}
+
+func ToListTaskAttachmentsResponse(taskId int32, attachments []domain.TaskAttachmentOutput) ListTaskAttachmentsResponse {
+	outAttachments := make([]TaskAttachmentResponse, len(attachments))
+	for index, attachment := range attachments {
+		outAttachments[index] = ToTaskAttachmentResponse(&attachment)
+	}
+
+	return ListTaskAttachmentsResponse{
+		TaskID:      taskId,
+		Attachments: outAttachments,
+	}
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type CreateTaskChecklistItemRequest struct {
+	Title string `json:"title" validate:"required,min=1,max=256"`
+}
+
+type UpdateTaskChecklistItemRequest struct {
+	Title    string `json:"title" validate:"required,min=1,max=256"`
+	IsDone   bool   `json:"is_done"`
+	Position int32  `json:"position" validate:"gte=0"`
+}
+
+type TaskChecklistItemResponse struct {
+	ID        int64  `json:"id"`
+	TaskID    int32  `json:"task_id"`
+	Title     string `json:"title"`
+	IsDone    bool   `json:"is_done"`
+	Position  int32  `json:"position"`
+	CreatedAt string `json:"created_at"`
+}
+
+func ToTaskChecklistItemResponse(item *domain.TaskChecklistItemOutput) TaskChecklistItemResponse {
+	return TaskChecklistItemResponse{
+		ID:        item.ID,
+		TaskID:    item.TaskID,
+		Title:     item.Title,
+		IsDone:    item.IsDone,
+		Position:  item.Position,
+		CreatedAt: item.CreatedAt.String(),
+	}
+}
+
+type ListTaskChecklistItemsResponse struct {
+	TaskID   int32                       `json:"task_id"`
+	Progress TaskChecklistProgressData   `json:"progress"`
+	Items    []TaskChecklistItemResponse `json:"items"`
+}
+
+func ToListTaskChecklistItemsResponse(taskId int32, items []domain.TaskChecklistItemOutput) ListTaskChecklistItemsResponse {
+	outItems := make([]TaskChecklistItemResponse, len(items))
+
+	var done int32
+	for index, item := range items {
+		outItems[index] = ToTaskChecklistItemResponse(&item)
+		if item.IsDone {
+			done++
+		}
+	}
+
+	return ListTaskChecklistItemsResponse{
+		TaskID: taskId,
+		Progress: TaskChecklistProgressData{
+			Done:  done,
+			Total: int32(len(items)),
+		},
+		Items: outItems,
+	}
+}
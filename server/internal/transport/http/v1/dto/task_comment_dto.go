@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type UpdateTaskCommentRequest struct {
+	Body string `json:"body" validate:"required,min=1,max=4096"`
+}
+
+type TaskCommentResponse struct {
+	ID        int64  `json:"id"`
+	TaskID    int32  `json:"task_id"`
+	UserID    int32  `json:"user_id"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func ToTaskCommentResponse(comment *domain.TaskCommentOutput) TaskCommentResponse {
+	return TaskCommentResponse{
+		ID:        comment.ID,
+		TaskID:    comment.TaskID,
+		UserID:    comment.UserID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt.String(),
+		UpdatedAt: comment.UpdatedAt.String(),
+	}
+}
+
+type ListTaskCommentsResponse struct {
+	TaskID   int32                 `json:"task_id"`
+	Comments []TaskCommentResponse `json:"comments"`
+}
+
+func ToListTaskCommentsResponse(taskId int32, comments []domain.TaskCommentOutput) ListTaskCommentsResponse {
+	outComments := make([]TaskCommentResponse, len(comments))
+	for index, comment := range comments {
+		outComments[index] = ToTaskCommentResponse(&comment)
+	}
+
+	return ListTaskCommentsResponse{
+		TaskID:   taskId,
+		Comments: outComments,
+	}
+}
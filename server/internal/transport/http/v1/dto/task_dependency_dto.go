@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type AddTaskDependencyRequest struct {
+	BlockedByTaskID int64 `json:"blocked_by_task_id" validate:"required,gt=0"`
+}
+
+type TaskBlockerData struct {
+	ID            int64  `json:"id"`
+	GoalID        int32  `json:"goal_id"`
+	Title         string `json:"title"`
+	IsDone        bool   `json:"is_done"`
+	ScheduledDate string `json:"scheduled_date"`
+}
+
+type ListTaskBlockersResponse struct {
+	TaskID   int64             `json:"task_id"`
+	Blocked  bool              `json:"blocked"`
+	Blockers []TaskBlockerData `json:"blockers"`
+}
+
+func ToListTaskBlockersResponse(taskId int64, blockers []domain.TaskOutput) ListTaskBlockersResponse {
+	blocked := false
+	outBlockers := make([]TaskBlockerData, len(blockers))
+	for index, blocker := range blockers {
+		outBlockers[index] = TaskBlockerData{
+			ID:            blocker.ID,
+			GoalID:        blocker.GoalID,
+			Title:         blocker.Title,
+			IsDone:        blocker.IsDone,
+			ScheduledDate: blocker.ScheduledDate.String(),
+		}
+		blocked = blocked || !blocker.IsDone
+	}
+
+	return ListTaskBlockersResponse{
+		TaskID:   taskId,
+		Blocked:  blocked,
+		Blockers: outBlockers,
+	}
+}
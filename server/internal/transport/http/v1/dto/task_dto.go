@@ -1,22 +1,35 @@
 package dto
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/ali-nur31/mile-do/internal/domain"
 )
 
 type CreateTaskRequest struct {
-	GoalID               int32  `json:"goal_id" validate:"required,gte=0"`
-	Title                string `json:"title" validate:"required,min=3,max=256"`
-	ScheduledDateTime    string `json:"scheduled_date_time" validate:"omitempty,min=10"`
-	ScheduledEndDateTime string `json:"scheduled_end_date_time" validate:"omitempty,min=10"`
+	GoalID               int32   `json:"goal_id" validate:"required,gte=0"`
+	Title                string  `json:"title" validate:"required,min=3,max=256"`
+	Description          string  `json:"description" validate:"omitempty,max=10000"`
+	ScheduledDateTime    string  `json:"scheduled_date_time" validate:"omitempty,min=10"`
+	ScheduledEndDateTime string  `json:"scheduled_end_date_time" validate:"omitempty,min=10"`
+	Priority             string  `json:"priority" validate:"omitempty,oneof=none low medium high"`
+	AssigneeID           int32   `json:"assignee_id" validate:"omitempty,gt=0"`
+	TagIDs               []int32 `json:"tag_ids" validate:"omitempty,dive,gt=0"`
 }
 
 type UpdateTaskRequest struct {
-	GoalID               int32  `json:"goal_id" validate:"required,gte=0"`
-	Title                string `json:"title" validate:"required,min=3,max=256"`
-	IsDone               bool   `json:"is_done"`
-	ScheduledDateTime    string `json:"scheduled_date_time" validate:"required,min=10"`
-	ScheduledEndDateTime string `json:"scheduled_end_date_time" validate:"omitempty,min=10"`
+	GoalID               int32   `json:"goal_id" validate:"required,gte=0"`
+	Title                string  `json:"title" validate:"required,min=3,max=256"`
+	Description          *string `json:"description" validate:"omitempty,max=10000"`
+	IsDone               bool    `json:"is_done"`
+	ScheduledDateTime    string  `json:"scheduled_date_time" validate:"required,min=10"`
+	ScheduledEndDateTime string  `json:"scheduled_end_date_time" validate:"omitempty,min=10"`
+	Priority             string  `json:"priority" validate:"omitempty,oneof=none low medium high"`
+	AssigneeID           *int32  `json:"assignee_id" validate:"omitempty,gte=0"`
+	TagIDs               []int32 `json:"tag_ids" validate:"omitempty,dive,gt=0"`
 }
 
 type CountCompletedTasksForTodayResponse struct {
@@ -31,52 +44,99 @@ func ToCountCompletedTasksForTodayResponse(output *domain.TodayProgressOutput) C
 	}
 }
 
+type StreakResponse struct {
+	CurrentStreak int32 `json:"current_streak"`
+	LongestStreak int32 `json:"longest_streak"`
+}
+
+func ToStreakResponse(output *domain.StreakOutput) StreakResponse {
+	return StreakResponse{
+		CurrentStreak: output.CurrentStreak,
+		LongestStreak: output.LongestStreak,
+	}
+}
+
+type TaskChecklistProgressData struct {
+	Done  int32 `json:"done"`
+	Total int32 `json:"total"`
+}
+
 type TaskResponse struct {
-	ID              int64  `json:"id"`
-	UserID          int32  `json:"user_id"`
-	GoalID          int32  `json:"goal_id"`
-	Title           string `json:"title"`
-	IsDone          bool   `json:"is_done"`
-	ScheduledDate   string `json:"scheduled_date"`
-	HasTime         bool   `json:"has_time"`
-	ScheduledTime   string `json:"scheduled_time"`
-	DurationMinutes int32  `json:"duration_minutes"`
-	RescheduleCount int32  `json:"reschedule_count"`
-	CreatedAt       string `json:"created_at"`
+	ID                    int64                     `json:"id"`
+	UserID                int32                     `json:"user_id"`
+	GoalID                int32                     `json:"goal_id"`
+	RecurringTemplateID   int32                     `json:"recurring_template_id"`
+	Title                 string                    `json:"title"`
+	Description           string                    `json:"description"`
+	IsDone                bool                      `json:"is_done"`
+	ScheduledDate         string                    `json:"scheduled_date"`
+	HasTime               bool                      `json:"has_time"`
+	ScheduledTime         string                    `json:"scheduled_time"`
+	DurationMinutes       int32                     `json:"duration_minutes"`
+	RescheduleCount       int32                     `json:"reschedule_count"`
+	Priority              string                    `json:"priority"`
+	IsRecurrenceException bool                      `json:"is_recurrence_exception"`
+	AssigneeID            int32                     `json:"assignee_id,omitempty"`
+	Blocked               bool                      `json:"blocked"`
+	ChecklistProgress     TaskChecklistProgressData `json:"checklist_progress"`
+	Tags                  []TagData                 `json:"tags"`
+	CompletedAt           string                    `json:"completed_at,omitempty"`
+	CreatedAt             string                    `json:"created_at"`
 }
 
 func ToTaskResponse(task *domain.TaskOutput) TaskResponse {
 	return TaskResponse{
-		ID:              task.ID,
-		UserID:          task.UserID,
-		GoalID:          task.GoalID,
-		Title:           task.Title,
-		IsDone:          task.IsDone,
-		ScheduledDate:   task.ScheduledDate.String(),
-		HasTime:         task.HasTime,
-		ScheduledTime:   task.ScheduledTime.String(),
-		DurationMinutes: task.DurationMinutes,
-		RescheduleCount: task.RescheduleCount,
-		CreatedAt:       task.CreatedAt.String(),
+		ID:                    task.ID,
+		UserID:                task.UserID,
+		GoalID:                task.GoalID,
+		RecurringTemplateID:   task.RecurringTemplateID,
+		Title:                 task.Title,
+		Description:           task.Description,
+		IsDone:                task.IsDone,
+		ScheduledDate:         task.ScheduledDate.String(),
+		HasTime:               task.HasTime,
+		ScheduledTime:         task.ScheduledTime.String(),
+		DurationMinutes:       task.DurationMinutes,
+		RescheduleCount:       task.RescheduleCount,
+		Priority:              task.Priority,
+		IsRecurrenceException: task.IsRecurrenceException,
+		AssigneeID:            task.AssigneeID,
+		Blocked:               task.Blocked,
+		ChecklistProgress: TaskChecklistProgressData{
+			Done:  task.ChecklistProgress.Done,
+			Total: task.ChecklistProgress.Total,
+		},
+		Tags:        ToTagDataList(task.Tags),
+		CompletedAt: toCompletedAtString(task.CompletedAt),
+		CreatedAt:   task.CreatedAt.String(),
 	}
 }
 
 type TaskData struct {
-	ID              int64  `json:"id"`
-	GoalID          int32  `json:"goal_id"`
-	Title           string `json:"title"`
-	IsDone          bool   `json:"is_done"`
-	ScheduledDate   string `json:"scheduled_date"`
-	HasTime         bool   `json:"has_time"`
-	ScheduledTime   string `json:"scheduled_time"`
-	DurationMinutes int32  `json:"duration_minutes"`
-	RescheduleCount int32  `json:"reschedule_count"`
-	CreatedAt       string `json:"created_at"`
+	ID                    int64                     `json:"id"`
+	GoalID                int32                     `json:"goal_id"`
+	RecurringTemplateID   int32                     `json:"recurring_template_id"`
+	Title                 string                    `json:"title"`
+	IsDone                bool                      `json:"is_done"`
+	ScheduledDate         string                    `json:"scheduled_date"`
+	HasTime               bool                      `json:"has_time"`
+	ScheduledTime         string                    `json:"scheduled_time"`
+	DurationMinutes       int32                     `json:"duration_minutes"`
+	RescheduleCount       int32                     `json:"reschedule_count"`
+	Priority              string                    `json:"priority"`
+	IsRecurrenceException bool                      `json:"is_recurrence_exception"`
+	AssigneeID            int32                     `json:"assignee_id,omitempty"`
+	Blocked               bool                      `json:"blocked"`
+	ChecklistProgress     TaskChecklistProgressData `json:"checklist_progress"`
+	Tags                  []TagData                 `json:"tags"`
+	CompletedAt           string                    `json:"completed_at,omitempty"`
+	CreatedAt             string                    `json:"created_at"`
 }
 
 type ListTasksResponse struct {
-	UserID   int32      `json:"user_id"`
-	TaskData []TaskData `json:"task_data"`
+	UserID     int32      `json:"user_id"`
+	TaskData   []TaskData `json:"task_data"`
+	NextCursor string     `json:"next_cursor,omitempty"`
 }
 
 func ToListTasksResponse(tasks []domain.TaskOutput) ListTasksResponse {
@@ -91,16 +151,27 @@ func ToListTasksResponse(tasks []domain.TaskOutput) ListTasksResponse {
 
 	for index, task := range tasks {
 		taskData[index] = TaskData{
-			ID:              task.ID,
-			GoalID:          task.GoalID,
-			Title:           task.Title,
-			IsDone:          task.IsDone,
-			ScheduledDate:   task.ScheduledDate.String(),
-			HasTime:         task.HasTime,
-			ScheduledTime:   task.ScheduledTime.String(),
-			DurationMinutes: task.DurationMinutes,
-			RescheduleCount: task.RescheduleCount,
-			CreatedAt:       task.CreatedAt.String(),
+			ID:                    task.ID,
+			GoalID:                task.GoalID,
+			RecurringTemplateID:   task.RecurringTemplateID,
+			Title:                 task.Title,
+			IsDone:                task.IsDone,
+			ScheduledDate:         task.ScheduledDate.String(),
+			HasTime:               task.HasTime,
+			ScheduledTime:         task.ScheduledTime.String(),
+			DurationMinutes:       task.DurationMinutes,
+			RescheduleCount:       task.RescheduleCount,
+			Priority:              task.Priority,
+			IsRecurrenceException: task.IsRecurrenceException,
+			AssigneeID:            task.AssigneeID,
+			Blocked:               task.Blocked,
+			ChecklistProgress: TaskChecklistProgressData{
+				Done:  task.ChecklistProgress.Done,
+				Total: task.ChecklistProgress.Total,
+			},
+			Tags:        ToTagDataList(task.Tags),
+			CompletedAt: toCompletedAtString(task.CompletedAt),
+			CreatedAt:   task.CreatedAt.String(),
 		}
 	}
 
@@ -109,3 +180,105 @@ func ToListTasksResponse(tasks []domain.TaskOutput) ListTasksResponse {
 		TaskData: taskData,
 	}
 }
+
+func ToListTasksPageResponse(page *domain.TaskPageOutput) ListTasksResponse {
+	response := ToListTasksResponse(page.Tasks)
+	if page.NextCursor != nil {
+		response.NextCursor = EncodeTaskCursor(page.NextCursor)
+	}
+
+	return response
+}
+
+type TaskFieldChangeData struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+type TaskActivityResponse struct {
+	ID        int64                          `json:"id"`
+	Action    string                         `json:"action"`
+	Changes   map[string]TaskFieldChangeData `json:"changes"`
+	CreatedAt string                         `json:"created_at"`
+}
+
+type ListTaskActivityResponse struct {
+	TaskID   int32                  `json:"task_id"`
+	Activity []TaskActivityResponse `json:"activity"`
+}
+
+func ToListTaskActivityResponse(taskId int32, activity []domain.TaskActivityOutput) ListTaskActivityResponse {
+	outActivity := make([]TaskActivityResponse, len(activity))
+	for index, entry := range activity {
+		changes := make(map[string]TaskFieldChangeData, len(entry.Changes))
+		for field, change := range entry.Changes {
+			changes[field] = TaskFieldChangeData{
+				Old: change.Old,
+				New: change.New,
+			}
+		}
+
+		outActivity[index] = TaskActivityResponse{
+			ID:        entry.ID,
+			Action:    entry.Action,
+			Changes:   changes,
+			CreatedAt: entry.CreatedAt.String(),
+		}
+	}
+
+	return ListTaskActivityResponse{
+		TaskID:   taskId,
+		Activity: outActivity,
+	}
+}
+
+type taskCursorData struct {
+	Priority string `json:"p"`
+	IsDone   bool   `json:"d"`
+	ID       int64  `json:"i"`
+}
+
+func EncodeTaskCursor(cursor *domain.TaskCursor) string {
+	encoded, _ := json.Marshal(taskCursorData{
+		Priority: cursor.Priority,
+		IsDone:   cursor.IsDone,
+		ID:       cursor.ID,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func DecodeTaskCursor(cursor string) (*domain.TaskCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	var data taskCursorData
+	if err = json.Unmarshal(decoded, &data); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	switch data.Priority {
+	case "none", "low", "medium", "high":
+	default:
+		return nil, fmt.Errorf("invalid cursor: unknown priority %q", data.Priority)
+	}
+
+	if data.ID <= 0 {
+		return nil, fmt.Errorf("invalid cursor: non-positive id %d", data.ID)
+	}
+
+	return &domain.TaskCursor{
+		Priority: data.Priority,
+		IsDone:   data.IsDone,
+		ID:       data.ID,
+	}, nil
+}
+
+func toCompletedAtString(completedAt time.Time) string {
+	if completedAt.IsZero() {
+		return ""
+	}
+	return completedAt.String()
+}
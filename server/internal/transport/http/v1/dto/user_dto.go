@@ -2,14 +2,36 @@ package dto
 
 import "github.com/ali-nur31/mile-do/internal/domain"
 
+type UpdateUserRequest struct {
+	TimeZone           string `json:"time_zone" validate:"omitempty,timezone"`
+	AutoRollover       *bool  `json:"auto_rollover"`
+	EmailNotifications *bool  `json:"email_notifications"`
+	EmailAgenda        *bool  `json:"email_agenda"`
+}
+
 type GetUserResponse struct {
-	Email     string `json:"email"`
-	CreatedAt string `json:"created_at"`
+	Email              string `json:"email"`
+	TimeZone           string `json:"time_zone"`
+	AutoRollover       bool   `json:"auto_rollover"`
+	EmailNotifications bool   `json:"email_notifications"`
+	EmailAgenda        bool   `json:"email_agenda"`
+	HasCalendarToken   bool   `json:"has_calendar_token"`
+	CreatedAt          string `json:"created_at"`
 }
 
 func ToGetUserResponse(output *domain.UserOutput) GetUserResponse {
 	return GetUserResponse{
-		Email:     output.Email,
-		CreatedAt: output.CreatedAt.String(),
+		Email:              output.Email,
+		TimeZone:           output.TimeZone,
+		AutoRollover:       output.AutoRollover,
+		EmailNotifications: output.EmailNotifications,
+		EmailAgenda:        output.EmailAgenda,
+		HasCalendarToken:   output.HasCalendarToken,
+		CreatedAt:          output.CreatedAt.String(),
 	}
 }
+
+type CalendarTokenResponse struct {
+	Token   string `json:"token"`
+	FeedUrl string `json:"feed_url"`
+}
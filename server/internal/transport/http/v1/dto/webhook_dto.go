@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type CreateWebhookRequest struct {
+	Url    string   `json:"url" validate:"required,http_url,max=2048"`
+	Secret string   `json:"secret" validate:"required,min=16,max=255"`
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=task.created task.updated task.completed task.deleted goal.created goal.updated goal.deleted reminder.due"`
+}
+
+type UpdateWebhookRequest struct {
+	Url      string   `json:"url" validate:"omitempty,http_url,max=2048"`
+	Secret   string   `json:"secret" validate:"omitempty,min=16,max=255"`
+	Events   []string `json:"events" validate:"omitempty,min=1,dive,oneof=task.created task.updated task.completed task.deleted goal.created goal.updated goal.deleted reminder.due"`
+	IsActive *bool    `json:"is_active"`
+}
+
+type WebhookResponse struct {
+	ID        int64    `json:"id"`
+	Url       string   `json:"url"`
+	Events    []string `json:"events"`
+	IsActive  bool     `json:"is_active"`
+	CreatedAt string   `json:"created_at"`
+}
+
+type ListWebhooksResponse struct {
+	UserID int32             `json:"user_id"`
+	Data   []WebhookResponse `json:"data"`
+}
+
+func ToWebhookResponse(webhook *domain.WebhookOutput) WebhookResponse {
+	return WebhookResponse{
+		ID:        webhook.ID,
+		Url:       webhook.Url,
+		Events:    webhook.Events,
+		IsActive:  webhook.IsActive,
+		CreatedAt: webhook.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToListWebhooksResponse(userId int32, webhooks []domain.WebhookOutput) ListWebhooksResponse {
+	data := make([]WebhookResponse, len(webhooks))
+	for i := range webhooks {
+		data[i] = ToWebhookResponse(&webhooks[i])
+	}
+
+	return ListWebhooksResponse{
+		UserID: userId,
+		Data:   data,
+	}
+}
+
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	Event          string          `json:"event"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	ResponseStatus int32           `json:"response_status,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	CreatedAt      string          `json:"created_at"`
+	DeliveredAt    string          `json:"delivered_at,omitempty"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	WebhookID int64                     `json:"webhook_id"`
+	Data      []WebhookDeliveryResponse `json:"data"`
+}
+
+func ToWebhookDeliveryResponse(delivery *domain.WebhookDeliveryOutput) WebhookDeliveryResponse {
+	response := WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		Event:          delivery.Event,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		Payload:        delivery.Payload,
+		CreatedAt:      delivery.CreatedAt.Format(time.RFC3339),
+	}
+
+	if !delivery.DeliveredAt.IsZero() {
+		response.DeliveredAt = delivery.DeliveredAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+func ToListWebhookDeliveriesResponse(webhookId int64, deliveries []domain.WebhookDeliveryOutput) ListWebhookDeliveriesResponse {
+	data := make([]WebhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		data[i] = ToWebhookDeliveryResponse(&deliveries[i])
+	}
+
+	return ListWebhookDeliveriesResponse{
+		WebhookID: webhookId,
+		Data:      data,
+	}
+}
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+type ReviewGoalData struct {
+	GoalID         int64  `json:"goal_id"`
+	Title          string `json:"title"`
+	TotalTasks     int32  `json:"total_tasks"`
+	CompletedTasks int32  `json:"completed_tasks"`
+}
+
+type ReviewTaskData struct {
+	TaskID          int64  `json:"task_id"`
+	GoalID          int32  `json:"goal_id"`
+	Title           string `json:"title"`
+	ScheduledDate   string `json:"scheduled_date"`
+	RescheduleCount int32  `json:"reschedule_count"`
+}
+
+type WeeklyReviewResponse struct {
+	ID                   int64            `json:"id"`
+	Week                 string           `json:"week"`
+	WeekStart            string           `json:"week_start"`
+	WeekEnd              string           `json:"week_end"`
+	TotalTasks           int32            `json:"total_tasks"`
+	CompletedTasks       int32            `json:"completed_tasks"`
+	PlannedMinutes       int32            `json:"planned_minutes"`
+	CompletedMinutes     int32            `json:"completed_minutes"`
+	Goals                []ReviewGoalData `json:"goals"`
+	OverdueTasks         []ReviewTaskData `json:"overdue_tasks"`
+	MostRescheduledTasks []ReviewTaskData `json:"most_rescheduled_tasks"`
+	CreatedAt            string           `json:"created_at"`
+}
+
+func ToWeeklyReviewResponse(output *domain.WeeklyReviewOutput) WeeklyReviewResponse {
+	goals := make([]ReviewGoalData, len(output.Goals))
+	for i, goal := range output.Goals {
+		goals[i] = ReviewGoalData{
+			GoalID:         goal.GoalID,
+			Title:          goal.Title,
+			TotalTasks:     goal.TotalTasks,
+			CompletedTasks: goal.CompletedTasks,
+		}
+	}
+
+	year, week := output.WeekStart.ISOWeek()
+
+	return WeeklyReviewResponse{
+		ID:                   output.ID,
+		Week:                 fmt.Sprintf("%d-W%02d", year, week),
+		WeekStart:            output.WeekStart.Format(time.DateOnly),
+		WeekEnd:              output.WeekEnd.Format(time.DateOnly),
+		TotalTasks:           output.TotalTasks,
+		CompletedTasks:       output.CompletedTasks,
+		PlannedMinutes:       output.PlannedMinutes,
+		CompletedMinutes:     output.CompletedMinutes,
+		Goals:                goals,
+		OverdueTasks:         toReviewTaskDataList(output.OverdueTasks),
+		MostRescheduledTasks: toReviewTaskDataList(output.MostRescheduledTasks),
+		CreatedAt:            output.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toReviewTaskDataList(outputs []domain.ReviewTaskOutput) []ReviewTaskData {
+	tasks := make([]ReviewTaskData, len(outputs))
+	for i, task := range outputs {
+		tasks[i] = ReviewTaskData{
+			TaskID:          task.TaskID,
+			GoalID:          task.GoalID,
+			Title:           task.Title,
+			ScheduledDate:   task.ScheduledDate.Format(time.DateOnly),
+			RescheduleCount: task.RescheduleCount,
+		}
+	}
+
+	return tasks
+}
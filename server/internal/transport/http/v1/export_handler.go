@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/labstack/echo/v4"
+)
+
+type ExportHandler struct {
+	service domain.ExportService
+}
+
+func NewExportHandler(service domain.ExportService) *ExportHandler {
+	return &ExportHandler{
+		service: service,
+	}
+}
+
+// CreateExportJob godoc
+// @Summary      export user data
+// @Description  start background export of goals, tasks, recurring tasks templates and settings of user into zip archive with JSON and CSV files
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      202  {object}  dto.ExportJobResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /users/me/export [post]
+func (h *ExportHandler) CreateExportJob(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	job, err := h.service.CreateExportJob(c.Request().Context(), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on creating export job", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusAccepted, dto.ToExportJobResponse(job))
+}
+
+// GetExportJobByID godoc
+// @Summary      get export job by :id
+// @Description  get status of export job by :id, with download=true the finished zip archive is returned instead
+// @Tags         users
+// @Accept       json
+// @Produce      json,application/zip
+// @Security     BearerAuth
+// @Param        id path int64 true "Export Job ID"
+// @Param        download query bool false "Download finished archive"
+// @Success      200  {object}  dto.ExportJobResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      409  {object}  map[string]string "Conflict"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /users/me/export/{id} [get]
+func (h *ExportHandler) GetExportJobByID(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	download := false
+	if value := c.QueryParam("download"); value != "" {
+		download, err = strconv.ParseBool(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+	}
+
+	job, err := h.service.GetExportJobByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find export job with provided id", "error": err.Error()})
+	}
+
+	if !download {
+		return c.JSON(http.StatusOK, dto.ToExportJobResponse(job))
+	}
+
+	if job.FilePath == "" {
+		return c.JSON(http.StatusConflict, map[string]string{"message": "export is not ready", "error": fmt.Sprintf("export job is %s", job.Status)})
+	}
+
+	return c.Attachment(job.FilePath, fmt.Sprintf("mile-do-export-%d.zip", job.ID))
+}
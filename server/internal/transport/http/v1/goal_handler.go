@@ -24,7 +24,7 @@ func NewGoalHandler(service domain.GoalService) *GoalHandler {
 
 // GetGoals godoc
 // @Summary      get goals
-// @Description  get list of goals
+// @Description  get list of own goals and goals shared with user, role of user is returned with every goal
 // @Tags         goals
 // @Accept       json
 // @Produce      json
@@ -131,7 +131,7 @@ func (h *GoalHandler) CreateGoal(c echo.Context) error {
 
 // UpdateGoal godoc
 // @Summary      update goal
-// @Description  update existing goal
+// @Description  update existing goal, only owner of goal can do it
 // @Tags         goals
 // @Accept       json
 // @Produce      json
@@ -139,6 +139,8 @@ func (h *GoalHandler) CreateGoal(c echo.Context) error {
 // @Param        input body dto.UpdateGoalRequest true "New Goal Info"
 // @Success      200  {object}  dto.GoalResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
 // @Failure      400  {object}  map[string]string "Bad Request"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
 // @Router       /goals/ [patch]
@@ -157,6 +159,15 @@ func (h *GoalHandler) UpdateGoal(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
 	}
 
+	dbGoal, err := h.service.GetGoalByID(c.Request().Context(), request.ID, int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find goal with provided id", "error": err.Error()})
+	}
+
+	if dbGoal.Role != domain.GoalRoleOwner {
+		return c.JSON(http.StatusForbidden, map[string]string{"message": "forbidden", "error": domain.GoalAccessDeniedError.Error()})
+	}
+
 	outGoal, err := h.service.UpdateGoal(c.Request().Context(), domain.UpdateGoalInput{
 		ID:           request.ID,
 		UserID:       int32(claims.ID),
@@ -175,7 +186,7 @@ func (h *GoalHandler) UpdateGoal(c echo.Context) error {
 
 // DeleteGoalByID godoc
 // @Summary      delete goal by :id
-// @Description  delete goal by :id
+// @Description  delete goal by :id, only owner of goal can do it
 // @Tags         goals
 // @Accept       json
 // @Produce      json
@@ -183,6 +194,7 @@ func (h *GoalHandler) UpdateGoal(c echo.Context) error {
 // @Param        id path int64 true "Goal ID"
 // @Success      201  {string}  map[string]string "goal has been removed"
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
 // @Failure      400  {object}  map[string]string "Bad Request"
 // @Router       /goals/{id} [delete]
@@ -203,6 +215,10 @@ func (h *GoalHandler) DeleteGoalByID(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
 	}
 
+	if goal.Role != domain.GoalRoleOwner {
+		return c.JSON(http.StatusForbidden, map[string]string{"message": "forbidden", "error": domain.GoalAccessDeniedError.Error()})
+	}
+
 	if strings.EqualFold(goal.Title, "routine") || strings.EqualFold(goal.Title, "other") {
 		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": "cannot delete default tasks"})
 	}
@@ -0,0 +1,384 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type GoalMemberHandler struct {
+	service domain.GoalMemberService
+}
+
+func NewGoalMemberHandler(service domain.GoalMemberService) *GoalMemberHandler {
+	return &GoalMemberHandler{
+		service: service,
+	}
+}
+
+// GetGoalMembers godoc
+// @Summary      get members of goal by :id
+// @Description  get members of shared goal with their roles, available to every member
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Goal ID"
+// @Success      200  {object}  dto.ListGoalMembersResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /goals/{id}/members [get]
+func (h *GoalMemberHandler) GetGoalMembers(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	goalId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	members, err := h.service.ListGoalMembers(c.Request().Context(), int64(goalId), int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on getting goal members")
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListGoalMembersResponse(int64(goalId), members))
+}
+
+// UpdateGoalMember godoc
+// @Summary      change role of goal member
+// @Description  change role of member by :user_id in goal by :id, only owner of goal can do it
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Goal ID"
+// @Param        user_id path int32 true "Member User ID"
+// @Param        input body dto.UpdateGoalMemberRequest true "Member Role"
+// @Success      200  {object}  dto.ListGoalMembersResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /goals/{id}/members/{user_id} [patch]
+func (h *GoalMemberHandler) UpdateGoalMember(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	goalId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	memberId, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.UpdateGoalMemberRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	err = h.service.UpdateGoalMember(c.Request().Context(), domain.UpdateGoalMemberInput{
+		GoalID:   int64(goalId),
+		UserID:   int32(claims.ID),
+		MemberID: int32(memberId),
+		Role:     request.Role,
+	})
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on updating goal member")
+	}
+
+	members, err := h.service.ListGoalMembers(c.Request().Context(), int64(goalId), int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on getting goal members")
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListGoalMembersResponse(int64(goalId), members))
+}
+
+// RemoveGoalMember godoc
+// @Summary      remove member from goal
+// @Description  remove member by :user_id from goal by :id, owner can remove anyone else and members can remove themselves to leave goal
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Goal ID"
+// @Param        user_id path int32 true "Member User ID"
+// @Success      200  {object}  map[string]string "member has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /goals/{id}/members/{user_id} [delete]
+func (h *GoalMemberHandler) RemoveGoalMember(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	goalId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	memberId, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	err = h.service.RemoveGoalMember(c.Request().Context(), int64(goalId), int32(memberId), int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on removing goal member")
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "member has been removed"})
+}
+
+// GetGoalInvitations godoc
+// @Summary      get pending invitations of goal by :id
+// @Description  get invitations to goal which are not accepted or declined yet, only owner of goal can see them
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Goal ID"
+// @Success      200  {object}  dto.ListGoalInvitationsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /goals/{id}/invitations [get]
+func (h *GoalMemberHandler) GetGoalInvitations(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	goalId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	invitations, err := h.service.ListGoalInvitations(c.Request().Context(), int64(goalId), int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on getting goal invitations")
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListGoalInvitationsResponse(invitations))
+}
+
+// InviteGoalMember godoc
+// @Summary      invite user to goal by email
+// @Description  invite email to goal by :id as editor or viewer, invitation is emailed when SMTP is configured, only owner of goal can invite
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Goal ID"
+// @Param        input body dto.InviteGoalMemberRequest true "Invitation Info"
+// @Success      201  {object}  dto.GoalInvitationResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /goals/{id}/invitations [post]
+func (h *GoalMemberHandler) InviteGoalMember(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	goalId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.InviteGoalMemberRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	invitation, err := h.service.InviteGoalMember(c.Request().Context(), domain.InviteGoalMemberInput{
+		GoalID: int64(goalId),
+		UserID: int32(claims.ID),
+		Email:  request.Email,
+		Role:   request.Role,
+	})
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on inviting goal member")
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToGoalInvitationResponse(invitation))
+}
+
+// DeleteGoalInvitation godoc
+// @Summary      revoke invitation to goal
+// @Description  revoke pending invitation by :invitation_id to goal by :id, only owner of goal can do it
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Goal ID"
+// @Param        invitation_id path int64 true "Invitation ID"
+// @Success      200  {object}  map[string]string "invitation has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /goals/{id}/invitations/{invitation_id} [delete]
+func (h *GoalMemberHandler) DeleteGoalInvitation(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	goalId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	invitationId, err := strconv.Atoi(c.Param("invitation_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	err = h.service.DeleteGoalInvitation(c.Request().Context(), int64(goalId), int64(invitationId), int32(claims.ID))
+	if errors.Is(err, domain.GoalAccessDeniedError) {
+		return c.JSON(http.StatusForbidden, map[string]string{"message": "forbidden", "error": err.Error()})
+	}
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find invitation with provided id", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "invitation has been removed"})
+}
+
+// GetReceivedInvitations godoc
+// @Summary      get invitations of current user
+// @Description  get pending invitations to shared goals sent to email of current user
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.ListGoalInvitationsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /invitations/ [get]
+func (h *GoalMemberHandler) GetReceivedInvitations(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	invitations, err := h.service.ListReceivedGoalInvitations(c.Request().Context(), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting received invitations", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListGoalInvitationsResponse(invitations))
+}
+
+// AcceptInvitation godoc
+// @Summary      accept invitation by :id
+// @Description  accept invitation sent to email of current user and join the shared goal with invited role
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Invitation ID"
+// @Success      200  {object}  dto.GoalResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /invitations/{id}/accept [post]
+func (h *GoalMemberHandler) AcceptInvitation(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	goal, err := h.service.AcceptGoalInvitation(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find invitation with provided id", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToGoalResponse(goal))
+}
+
+// DeclineInvitation godoc
+// @Summary      decline invitation by :id
+// @Description  decline invitation sent to email of current user
+// @Tags         invitations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Invitation ID"
+// @Success      200  {object}  map[string]string "invitation has been declined"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /invitations/{id}/decline [post]
+func (h *GoalMemberHandler) DeclineInvitation(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	err = h.service.DeclineGoalInvitation(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find invitation with provided id", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "invitation has been declined"})
+}
+
+// goalMemberErrorResponse maps errors of sharing goals to responses, unknown errors are logged with logMessage.
+func goalMemberErrorResponse(c echo.Context, err error, logMessage string) error {
+	switch {
+	case errors.Is(err, domain.GoalAccessDeniedError):
+		return c.JSON(http.StatusForbidden, map[string]string{"message": "forbidden", "error": err.Error()})
+	case errors.Is(err, domain.GoalMemberNotFoundError):
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find goal member with provided id", "error": err.Error()})
+	case errors.Is(err, domain.GoalOwnerMemberError), errors.Is(err, domain.GoalMemberExistsError), errors.Is(err, domain.InvalidGoalInvitationError), errors.Is(err, domain.InvalidAssigneeError):
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	slog.Error(logMessage, "error", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+}
@@ -0,0 +1,264 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type HabitHandler struct {
+	service                       domain.HabitService
+	recurringTasksTemplateService domain.RecurringTasksTemplateService
+	goalMemberService             domain.GoalMemberService
+}
+
+func NewHabitHandler(service domain.HabitService, recurringTasksTemplateService domain.RecurringTasksTemplateService, goalMemberService domain.GoalMemberService) *HabitHandler {
+	return &HabitHandler{
+		service:                       service,
+		recurringTasksTemplateService: recurringTasksTemplateService,
+		goalMemberService:             goalMemberService,
+	}
+}
+
+// GetHabits godoc
+// @Summary      get habits
+// @Description  get completion grid of every recurring tasks template in range, each day is done, missed, pending or unscheduled
+// @Tags         habits
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        after_date query string false "first date of range, defaults to 29 days before before_date"
+// @Param        before_date query string false "last date of range, defaults to today in user's time zone"
+// @Success      200  {object}  dto.ListHabitsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /habits/ [get]
+func (h *HabitHandler) GetHabits(c echo.Context) error {
+	afterDate, beforeDate, err := parseHabitRange(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, after_date and before_date must be in YYYY-MM-DD format", "error": err.Error()})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	habits, err := h.service.ListHabits(c.Request().Context(), domain.GetHabitsInput{
+		UserID:     int32(claims.ID),
+		AfterDate:  afterDate,
+		BeforeDate: beforeDate,
+	})
+	if err != nil {
+		if errors.Is(err, domain.InvalidHabitRangeError) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+		slog.Error("failed on getting habits", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListHabitsResponse(int32(claims.ID), habits))
+}
+
+// GetHabitByID godoc
+// @Summary      get habit by :id
+// @Description  get completion grid of recurring tasks template by :id in range, each day is done, missed, pending or unscheduled
+// @Tags         habits
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Recurring Tasks Template ID"
+// @Param        after_date query string false "first date of range, defaults to 29 days before before_date"
+// @Param        before_date query string false "last date of range, defaults to today in user's time zone"
+// @Success      200  {object}  dto.HabitResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /habits/{id} [get]
+func (h *HabitHandler) GetHabitByID(c echo.Context) error {
+	templateId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	afterDate, beforeDate, err := parseHabitRange(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, after_date and before_date must be in YYYY-MM-DD format", "error": err.Error()})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	_, err = h.recurringTasksTemplateService.GetRecurringTasksTemplateByID(c.Request().Context(), int64(templateId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find recurring tasks template with provided id", "error": err.Error()})
+	}
+
+	habit, err := h.service.GetHabitByID(c.Request().Context(), int64(templateId), domain.GetHabitsInput{
+		UserID:     int32(claims.ID),
+		AfterDate:  afterDate,
+		BeforeDate: beforeDate,
+	})
+	if err != nil {
+		if errors.Is(err, domain.InvalidHabitRangeError) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+		slog.Error("failed on getting habit by id", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToHabitResponse(habit))
+}
+
+// CheckInHabit godoc
+// @Summary      check in habit by :id
+// @Description  complete occurrence of recurring tasks template by :id on date up to today, task of that day is created when it doesn't exist yet
+// @Tags         habits
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Recurring Tasks Template ID"
+// @Param        input body dto.HabitCheckInRequest true "Check-in date"
+// @Success      200  {object}  dto.TaskResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /habits/{id}/check-ins [post]
+func (h *HabitHandler) CheckInHabit(c echo.Context) error {
+	templateId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	var request dto.HabitCheckInRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	date, err := time.Parse(time.DateOnly, request.Date)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, date must be in YYYY-MM-DD format", "error": err.Error()})
+	}
+
+	template, err := h.recurringTasksTemplateService.GetRecurringTasksTemplateByID(c.Request().Context(), int64(templateId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find recurring tasks template with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), template.GoalID, template.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing habit for check-in")
+	}
+
+	task, err := h.service.CheckInHabit(c.Request().Context(), domain.HabitCheckInInput{
+		UserID:     int32(claims.ID),
+		TemplateID: int64(templateId),
+		Date:       date,
+	})
+	if err != nil {
+		if errors.Is(err, domain.InvalidHabitCheckInDateError) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+		slog.Error("failed on checking in habit", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToTaskResponse(task))
+}
+
+// UndoHabitCheckIn godoc
+// @Summary      undo check-in of habit by :id on :date
+// @Description  reopen completed occurrence of recurring tasks template by :id on :date
+// @Tags         habits
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Recurring Tasks Template ID"
+// @Param        date path string true "Check-in date in YYYY-MM-DD format"
+// @Success      200  {object}  map[string]string "Check-in has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /habits/{id}/check-ins/{date} [delete]
+func (h *HabitHandler) UndoHabitCheckIn(c echo.Context) error {
+	templateId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	date, err := time.Parse(time.DateOnly, c.Param("date"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, date must be in YYYY-MM-DD format", "error": err.Error()})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	template, err := h.recurringTasksTemplateService.GetRecurringTasksTemplateByID(c.Request().Context(), int64(templateId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find recurring tasks template with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), template.GoalID, template.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing habit for undoing check-in")
+	}
+
+	err = h.service.UndoHabitCheckIn(c.Request().Context(), domain.HabitCheckInInput{
+		UserID:     int32(claims.ID),
+		TemplateID: int64(templateId),
+		Date:       date,
+	})
+	if err != nil {
+		slog.Error("failed on undoing habit check-in", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "check-in has been removed"})
+}
+
+func parseHabitRange(c echo.Context) (time.Time, time.Time, error) {
+	var afterDate, beforeDate time.Time
+	var err error
+	if afterDateParam := c.QueryParam("after_date"); afterDateParam != "" {
+		afterDate, err = time.Parse(time.DateOnly, afterDateParam)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	if beforeDateParam := c.QueryParam("before_date"); beforeDateParam != "" {
+		beforeDate, err = time.Parse(time.DateOnly, beforeDateParam)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	return afterDate, beforeDate, nil
+}
@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/labstack/echo/v4"
+)
+
+// maxImportFileSize limits uploaded files, they travel to the worker inside the job payload.
+const maxImportFileSize = 5 << 20
+
+type ImportHandler struct {
+	service     domain.ImportService
+	goalService domain.GoalService
+}
+
+func NewImportHandler(service domain.ImportService, goalService domain.GoalService) *ImportHandler {
+	return &ImportHandler{
+		service:     service,
+		goalService: goalService,
+	}
+}
+
+// ImportIcs godoc
+// @Summary      import iCalendar file
+// @Description  upload .ics file to import its events and to-dos into goal, recurring events become recurring tasks templates; import runs in background
+// @Tags         imports
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        file formData file true "iCalendar file"
+// @Param        goal_id formData int32 true "Goal ID"
+// @Success      202  {object}  dto.ImportJobResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /import/ics [post]
+func (h *ImportHandler) ImportIcs(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	goalId, err := strconv.Atoi(c.FormValue("goal_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	goal, err := h.goalService.GetGoalByID(c.Request().Context(), int64(goalId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find goal with provided id", "error": err.Error()})
+	}
+
+	if !domain.IsGoalRoleAtLeast(goal.Role, domain.GoalRoleEditor) {
+		return c.JSON(http.StatusForbidden, map[string]string{"message": "forbidden", "error": domain.GoalAccessDeniedError.Error()})
+	}
+
+	content, err := readImportFile(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	job, err := h.service.CreateImportJob(c.Request().Context(), domain.CreateImportJobInput{
+		UserID:  int32(claims.ID),
+		GoalID:  int32(goalId),
+		Source:  domain.ImportSourceIcs,
+		Content: content,
+	})
+	if err != nil {
+		slog.Error("failed on creating ics import job", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusAccepted, dto.ToImportJobResponse(job))
+}
+
+// ImportCsv godoc
+// @Summary      import TickTick or Todoist CSV backup
+// @Description  upload TickTick backup or Todoist project export, lists become goals and missing goals are created; with dry_run=true rows are only checked. Nothing is imported when any row fails, row errors are returned with 422
+// @Tags         imports
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        file formData file true "CSV file"
+// @Param        source formData string true "Source of backup" Enums(ticktick, todoist)
+// @Param        dry_run formData bool false "Only check rows"
+// @Success      200  {object}  dto.ImportCsvResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      422  {object}  dto.ImportCsvResponse
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /import/csv [post]
+func (h *ImportHandler) ImportCsv(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	source := c.FormValue("source")
+	if source != domain.ImportSourceTickTick && source != domain.ImportSourceTodoist {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": fmt.Sprintf("unsupported source %q", source)})
+	}
+
+	dryRun := false
+	if value := c.FormValue("dry_run"); value != "" {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+	}
+
+	content, err := readImportFile(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	fileHeader, _ := c.FormFile("file")
+
+	output, err := h.service.ImportCsv(c.Request().Context(), domain.ImportCsvInput{
+		UserID:   int32(claims.ID),
+		Source:   source,
+		FileName: fileHeader.Filename,
+		Content:  content,
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		if errors.Is(err, domain.InvalidImportFileError) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+		slog.Error("failed on importing csv", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	if !dryRun && len(output.RowErrors) > 0 {
+		return c.JSON(http.StatusUnprocessableEntity, dto.ToImportCsvResponse(output))
+	}
+
+	return c.JSON(http.StatusOK, dto.ToImportCsvResponse(output))
+}
+
+// GetImportJobByID godoc
+// @Summary      get import job by :id
+// @Description  get status and progress of import job by :id
+// @Tags         imports
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Import Job ID"
+// @Success      200  {object}  dto.ImportJobResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /import/{id} [get]
+func (h *ImportHandler) GetImportJobByID(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	job, err := h.service.GetImportJobByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find import job with provided id", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToImportJobResponse(job))
+}
+
+func readImportFile(c echo.Context) ([]byte, error) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+
+	if fileHeader.Size > maxImportFileSize {
+		return nil, fmt.Errorf("file is larger than %d bytes", maxImportFileSize)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(io.LimitReader(file, maxImportFileSize))
+}
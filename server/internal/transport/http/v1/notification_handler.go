@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationHandler struct {
+	service domain.NotificationService
+}
+
+func NewNotificationHandler(service domain.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		service: service,
+	}
+}
+
+// GetNotifications godoc
+// @Summary      get notifications
+// @Description  get latest notifications of user from in-app inbox, newest first
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        unread query bool false "get only unread notifications"
+// @Success      200  {object}  dto.ListNotificationsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /notifications [get]
+func (h *NotificationHandler) GetNotifications(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	unreadOnly := false
+	if value := c.QueryParam("unread"); value != "" {
+		unreadOnly, err = strconv.ParseBool(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+	}
+
+	notifications, err := h.service.ListNotifications(c.Request().Context(), int32(claims.ID), unreadOnly)
+	if err != nil {
+		slog.Error("failed on getting notifications", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListNotificationsResponse(int32(claims.ID), notifications))
+}
+
+// MarkNotificationAsRead godoc
+// @Summary      mark notification as read by :id
+// @Description  mark notification by :id as read, already read notification keeps its read time
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Notification ID"
+// @Success      200  {object}  dto.NotificationResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /notifications/{id}/read [patch]
+func (h *NotificationHandler) MarkNotificationAsRead(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	notification, err := h.service.MarkNotificationAsRead(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find notification with provided id", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToNotificationResponse(notification))
+}
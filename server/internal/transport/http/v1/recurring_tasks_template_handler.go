@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -14,12 +15,14 @@ import (
 )
 
 type RecurringTasksTemplateHandler struct {
-	service domain.RecurringTasksTemplateService
+	service           domain.RecurringTasksTemplateService
+	goalMemberService domain.GoalMemberService
 }
 
-func NewRecurringTasksTemplateHandler(service domain.RecurringTasksTemplateService) *RecurringTasksTemplateHandler {
+func NewRecurringTasksTemplateHandler(service domain.RecurringTasksTemplateService, goalMemberService domain.GoalMemberService) *RecurringTasksTemplateHandler {
 	return &RecurringTasksTemplateHandler{
-		service: service,
+		service:           service,
+		goalMemberService: goalMemberService,
 	}
 }
 
@@ -84,7 +87,7 @@ func (h *RecurringTasksTemplateHandler) GetRecurringTasksTemplateByID(c echo.Con
 
 // CreateRecurringTasksTemplate godoc
 // @Summary      create new recurring tasks template
-// @Description  create new recurring tasks template
+// @Description  create new recurring tasks template, generated tasks are assigned to assignee_ids in turn
 // @Tags         recurring-tasks-templates
 // @Accept       json
 // @Produce      json
@@ -92,6 +95,7 @@ func (h *RecurringTasksTemplateHandler) GetRecurringTasksTemplateByID(c echo.Con
 // @Param        input body dto.UpdateRecurringTasksTemplateRequest true "Recurring Tasks Template Info"
 // @Success      201  {object}  dto.RecurringTasksTemplateResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
 // @Failure      400  {object}  map[string]string "Bad Request"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
 // @Router       /recurring-tasks-templates/ [post]
@@ -120,17 +124,49 @@ func (h *RecurringTasksTemplateHandler) CreateRecurringTasksTemplate(c echo.Cont
 		}
 	}
 
+	exdates, err := parseDates(request.Exdates)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	rdates, err := parseDates(request.Rdates)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if request.GoalID != 0 {
+		err = h.goalMemberService.AuthorizeGoal(c.Request().Context(), int64(request.GoalID), int32(claims.ID), domain.GoalRoleEditor)
+		if err != nil {
+			return goalMemberErrorResponse(c, err, "failed on authorizing goal for creating recurring tasks template")
+		}
+	}
+
+	err = h.goalMemberService.AuthorizeAssignees(c.Request().Context(), request.GoalID, int32(claims.ID), request.AssigneeIDs)
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing assignees for creating recurring tasks template")
+	}
+
 	template := domain.CreateRecurringTasksTemplateInput{
-		UserID:            int32(claims.ID),
-		GoalID:            request.GoalID,
-		Title:             request.Title,
-		ScheduledDatetime: startDatetime,
-		HasTime:           request.HasTime,
-		DurationMinutes:   int32(duration),
-		RecurrenceRrule:   request.RecurrenceRrule,
+		UserID:                 int32(claims.ID),
+		GoalID:                 request.GoalID,
+		Title:                  request.Title,
+		ScheduledDatetime:      startDatetime,
+		HasTime:                request.HasTime,
+		DurationMinutes:        int32(duration / time.Minute),
+		RecurrenceRrule:        request.RecurrenceRrule,
+		RecurrenceMode:         request.RecurrenceMode,
+		RecurrenceIntervalDays: request.RecurrenceIntervalDays,
+		Priority:               request.Priority,
+		Exdates:                exdates,
+		Rdates:                 rdates,
+		AssigneeIDs:            request.AssigneeIDs,
+		TagIDs:                 request.TagIDs,
 	}
 
 	outTemplate, err := h.service.CreateRecurringTasksTemplate(c.Request().Context(), template)
+	if errors.Is(err, domain.InvalidTagError) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
 	if err != nil {
 		slog.Error("failed on creating recurring tasks template", "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
@@ -141,7 +177,7 @@ func (h *RecurringTasksTemplateHandler) CreateRecurringTasksTemplate(c echo.Cont
 
 // UpdateRecurringTasksTemplateByID godoc
 // @Summary      update recurring tasks template by :id
-// @Description  update existing recurring tasks template by :id
+// @Description  update existing recurring tasks template by :id, assignee_ids replaces assignees when provided
 // @Tags         recurring-tasks-templates
 // @Accept       json
 // @Produce      json
@@ -150,6 +186,7 @@ func (h *RecurringTasksTemplateHandler) CreateRecurringTasksTemplate(c echo.Cont
 // @Param        input body dto.UpdateRecurringTasksTemplateRequest true "New Recurring Tasks Template Info"
 // @Success      200  {object}  dto.RecurringTasksTemplateResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
 // @Failure      404  {object}  map[string]string "Not Found"
 // @Failure      400  {object}  map[string]string "Bad Request"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
@@ -179,22 +216,73 @@ func (h *RecurringTasksTemplateHandler) UpdateRecurringTasksTemplateByID(c echo.
 		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find recurring tasks template with provided id", "error": err.Error()})
 	}
 
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTemplate.GoalID, dbTemplate.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing recurring tasks template for updating")
+	}
+
+	if request.GoalID != 0 && request.GoalID != dbTemplate.GoalID {
+		err = h.goalMemberService.AuthorizeGoal(c.Request().Context(), int64(request.GoalID), int32(claims.ID), domain.GoalRoleEditor)
+		if err != nil {
+			return goalMemberErrorResponse(c, err, "failed on authorizing goal for updating recurring tasks template")
+		}
+	}
+
+	// omitted assignees keep the current ones
+	assigneeIds := dbTemplate.AssigneeIDs
+	if request.AssigneeIDs != nil {
+		assigneeIds = request.AssigneeIDs
+	}
+
+	err = h.goalMemberService.AuthorizeAssignees(c.Request().Context(), request.GoalID, dbTemplate.UserID, assigneeIds)
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing assignees for updating recurring tasks template")
+	}
+
+	if request.RecurrenceMode != "" && request.RecurrenceMode != dbTemplate.RecurrenceMode {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": "recurrence mode of existing template cannot be changed"})
+	}
+
 	startDatetime, duration, err := convertDateTimeAndTime(request.ScheduledDatetime, request.ScheduledEndTime)
 	if err != nil {
 		slog.Error("failed on updating recurring tasks template by id", "error", err)
 		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
 	}
 
+	priority := dbTemplate.Priority
+	if request.Priority != "" {
+		priority = request.Priority
+	}
+
+	exdates, err := parseDates(request.Exdates)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	rdates, err := parseDates(request.Rdates)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
 	outTemplate, err := h.service.UpdateRecurringTasksTemplateByID(c.Request().Context(), *dbTemplate, domain.UpdateRecurringTasksTemplateInput{
-		ID:                int64(templateId),
-		UserID:            int32(claims.ID),
-		GoalID:            request.GoalID,
-		Title:             request.Title,
-		ScheduledDatetime: startDatetime,
-		HasTime:           request.HasTime,
-		DurationMinutes:   int32(duration),
-		RecurrenceRrule:   request.RecurrenceRrule,
+		ID:                     int64(templateId),
+		UserID:                 dbTemplate.UserID,
+		GoalID:                 request.GoalID,
+		Title:                  request.Title,
+		ScheduledDatetime:      startDatetime,
+		HasTime:                request.HasTime,
+		DurationMinutes:        int32(duration / time.Minute),
+		RecurrenceRrule:        request.RecurrenceRrule,
+		RecurrenceIntervalDays: request.RecurrenceIntervalDays,
+		Priority:               priority,
+		Exdates:                exdates,
+		Rdates:                 rdates,
+		AssigneeIDs:            request.AssigneeIDs,
+		TagIDs:                 request.TagIDs,
 	})
+	if errors.Is(err, domain.InvalidTagError) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
 	if err != nil {
 		slog.Error("failed on updating recurring tasks template by id", "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
@@ -213,6 +301,7 @@ func (h *RecurringTasksTemplateHandler) UpdateRecurringTasksTemplateByID(c echo.
 // @Param        id path int64 true "Recurring Tasks Template ID"
 // @Success      201  {string}  map[string]string "Recurring tasks template has been removed"
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
 // @Failure      404  {object}  map[string]string "Not Found"
 // @Failure      400  {object}  map[string]string "Bad Request"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
@@ -228,7 +317,17 @@ func (h *RecurringTasksTemplateHandler) DeleteRecurringTasksTemplateByID(c echo.
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
 	}
 
-	err = h.service.DeleteRecurringTasksTemplateByID(c.Request().Context(), int64(id), int32(claims.ID))
+	dbTemplate, err := h.service.GetRecurringTasksTemplateByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "recurring tasks template not found", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTemplate.GoalID, dbTemplate.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing recurring tasks template for deletion")
+	}
+
+	err = h.service.DeleteRecurringTasksTemplateByID(c.Request().Context(), int64(id), dbTemplate.UserID)
 	if err != nil {
 		slog.Error("failed on deleting recurring tasks template by id", "error", err)
 		return c.JSON(http.StatusNotFound, map[string]string{"message": "recurring tasks template not found", "error": err.Error()})
@@ -237,6 +336,24 @@ func (h *RecurringTasksTemplateHandler) DeleteRecurringTasksTemplateByID(c echo.
 	return c.JSON(http.StatusOK, map[string]string{"message": "recurring tasks template has been removed"})
 }
 
+// parseDates keeps nil as nil so that omitted exdates and rdates leave the stored ones untouched on update.
+func parseDates(values []string) ([]time.Time, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	dates := make([]time.Time, len(values))
+	for i, value := range values {
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date format: %v", err)
+		}
+		dates[i] = date
+	}
+
+	return dates, nil
+}
+
 func convertDateTimeAndTime(startDateTimeString, endTimeString string) (time.Time, time.Duration, error) {
 	var startTime time.Time
 	duration := 15 * time.Minute
@@ -0,0 +1,300 @@
+package v1
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type ReminderHandler struct {
+	service                       domain.ReminderService
+	taskService                   domain.TaskService
+	recurringTasksTemplateService domain.RecurringTasksTemplateService
+}
+
+func NewReminderHandler(service domain.ReminderService, taskService domain.TaskService, recurringTasksTemplateService domain.RecurringTasksTemplateService) *ReminderHandler {
+	return &ReminderHandler{
+		service:                       service,
+		taskService:                   taskService,
+		recurringTasksTemplateService: recurringTasksTemplateService,
+	}
+}
+
+// GetTaskReminders godoc
+// @Summary      get reminders of task by :id
+// @Description  get reminders of task by :id ordered by offset
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Success      200  {object}  dto.ListRemindersResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/reminders [get]
+func (h *ReminderHandler) GetTaskReminders(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	reminders, err := h.service.ListTaskReminders(c.Request().Context(), int32(taskId), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting task reminders", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListRemindersResponse(int32(claims.ID), reminders))
+}
+
+// CreateTaskReminder godoc
+// @Summary      create reminder in task by :id
+// @Description  remind about task by :id offset minutes before it starts, only tasks with time are reminded
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        input body dto.CreateReminderRequest true "Reminder Info"
+// @Success      201  {object}  dto.ReminderResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/reminders [post]
+func (h *ReminderHandler) CreateTaskReminder(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.CreateReminderRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	_, err = h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	reminder, err := h.service.CreateReminder(c.Request().Context(), domain.CreateReminderInput{
+		UserID:        int32(claims.ID),
+		TaskID:        int32(taskId),
+		OffsetMinutes: request.OffsetMinutes,
+	})
+	if err != nil {
+		slog.Error("failed on creating task reminder", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToReminderResponse(reminder))
+}
+
+// DeleteTaskReminder godoc
+// @Summary      delete reminder by :reminder_id
+// @Description  delete reminder by :reminder_id of task by :id and cancel its pending notifications
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        reminder_id path int64 true "Reminder ID"
+// @Success      200  {object}  map[string]string "reminder has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/reminders/{reminder_id} [delete]
+func (h *ReminderHandler) DeleteTaskReminder(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	reminderId, err := strconv.Atoi(c.Param("reminder_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	reminder, err := h.service.GetReminderByID(c.Request().Context(), int64(reminderId), int32(claims.ID))
+	if err != nil || reminder.TaskID != int32(taskId) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find reminder with provided id", "error": "reminder not found"})
+	}
+
+	err = h.service.DeleteReminderByID(c.Request().Context(), int64(reminderId), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on deleting reminder by id", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "reminder has been removed"})
+}
+
+// GetRecurringTasksTemplateReminders godoc
+// @Summary      get reminders of recurring tasks template by :id
+// @Description  get reminders of recurring tasks template by :id ordered by offset
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Recurring Tasks Template ID"
+// @Success      200  {object}  dto.ListRemindersResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /recurring-tasks-templates/{id}/reminders [get]
+func (h *ReminderHandler) GetRecurringTasksTemplateReminders(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	templateId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.recurringTasksTemplateService.GetRecurringTasksTemplateByID(c.Request().Context(), int64(templateId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find recurring tasks template with provided id", "error": err.Error()})
+	}
+
+	reminders, err := h.service.ListRecurringTasksTemplateReminders(c.Request().Context(), int32(templateId), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting recurring tasks template reminders", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListRemindersResponse(int32(claims.ID), reminders))
+}
+
+// CreateRecurringTasksTemplateReminder godoc
+// @Summary      create reminder in recurring tasks template by :id
+// @Description  remind about every upcoming task of recurring tasks template by :id offset minutes before it starts
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Recurring Tasks Template ID"
+// @Param        input body dto.CreateReminderRequest true "Reminder Info"
+// @Success      201  {object}  dto.ReminderResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /recurring-tasks-templates/{id}/reminders [post]
+func (h *ReminderHandler) CreateRecurringTasksTemplateReminder(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	templateId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.CreateReminderRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	_, err = h.recurringTasksTemplateService.GetRecurringTasksTemplateByID(c.Request().Context(), int64(templateId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find recurring tasks template with provided id", "error": err.Error()})
+	}
+
+	reminder, err := h.service.CreateReminder(c.Request().Context(), domain.CreateReminderInput{
+		UserID:              int32(claims.ID),
+		RecurringTemplateID: int32(templateId),
+		OffsetMinutes:       request.OffsetMinutes,
+	})
+	if err != nil {
+		slog.Error("failed on creating recurring tasks template reminder", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToReminderResponse(reminder))
+}
+
+// DeleteRecurringTasksTemplateReminder godoc
+// @Summary      delete reminder by :reminder_id
+// @Description  delete reminder by :reminder_id of recurring tasks template by :id and cancel its pending notifications
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Recurring Tasks Template ID"
+// @Param        reminder_id path int64 true "Reminder ID"
+// @Success      200  {object}  map[string]string "reminder has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /recurring-tasks-templates/{id}/reminders/{reminder_id} [delete]
+func (h *ReminderHandler) DeleteRecurringTasksTemplateReminder(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	templateId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	reminderId, err := strconv.Atoi(c.Param("reminder_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	reminder, err := h.service.GetReminderByID(c.Request().Context(), int64(reminderId), int32(claims.ID))
+	if err != nil || reminder.RecurringTemplateID != int32(templateId) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find reminder with provided id", "error": "reminder not found"})
+	}
+
+	err = h.service.DeleteReminderByID(c.Request().Context(), int64(reminderId), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on deleting reminder by id", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "reminder has been removed"})
+}
@@ -15,8 +15,23 @@ type Router struct {
 	authHandler                   AuthHandler
 	userHandler                   UserHandler
 	goalHandler                   GoalHandler
+	goalMemberHandler             GoalMemberHandler
 	recurringTasksTemplateHandler RecurringTasksTemplateHandler
 	taskHandler                   TaskHandler
+	taskChecklistItemHandler      TaskChecklistItemHandler
+	taskCommentHandler            TaskCommentHandler
+	taskAttachmentHandler         TaskAttachmentHandler
+	taskDependencyHandler         TaskDependencyHandler
+	tagHandler                    TagHandler
+	calendarHandler               CalendarHandler
+	importHandler                 ImportHandler
+	exportHandler                 ExportHandler
+	analyticsHandler              AnalyticsHandler
+	habitHandler                  HabitHandler
+	weeklyReviewHandler           WeeklyReviewHandler
+	reminderHandler               ReminderHandler
+	notificationHandler           NotificationHandler
+	webhookHandler                WebhookHandler
 }
 
 func NewRouter(
@@ -25,8 +40,23 @@ func NewRouter(
 	authHandler AuthHandler,
 	userHandler UserHandler,
 	goalHandler GoalHandler,
+	goalMemberHandler GoalMemberHandler,
 	recurringTasksTemplateHandler RecurringTasksTemplateHandler,
 	taskHandler TaskHandler,
+	taskChecklistItemHandler TaskChecklistItemHandler,
+	taskCommentHandler TaskCommentHandler,
+	taskAttachmentHandler TaskAttachmentHandler,
+	taskDependencyHandler TaskDependencyHandler,
+	tagHandler TagHandler,
+	calendarHandler CalendarHandler,
+	importHandler ImportHandler,
+	exportHandler ExportHandler,
+	analyticsHandler AnalyticsHandler,
+	habitHandler HabitHandler,
+	weeklyReviewHandler WeeklyReviewHandler,
+	reminderHandler ReminderHandler,
+	notificationHandler NotificationHandler,
+	webhookHandler WebhookHandler,
 ) *Router {
 	return &Router{
 		redisCfg:                      redisCfg,
@@ -34,8 +64,23 @@ func NewRouter(
 		authHandler:                   authHandler,
 		userHandler:                   userHandler,
 		goalHandler:                   goalHandler,
+		goalMemberHandler:             goalMemberHandler,
 		recurringTasksTemplateHandler: recurringTasksTemplateHandler,
 		taskHandler:                   taskHandler,
+		taskChecklistItemHandler:      taskChecklistItemHandler,
+		taskCommentHandler:            taskCommentHandler,
+		taskAttachmentHandler:         taskAttachmentHandler,
+		taskDependencyHandler:         taskDependencyHandler,
+		tagHandler:                    tagHandler,
+		calendarHandler:               calendarHandler,
+		importHandler:                 importHandler,
+		exportHandler:                 exportHandler,
+		analyticsHandler:              analyticsHandler,
+		habitHandler:                  habitHandler,
+		weeklyReviewHandler:           weeklyReviewHandler,
+		reminderHandler:               reminderHandler,
+		notificationHandler:           notificationHandler,
+		webhookHandler:                webhookHandler,
 	}
 }
 
@@ -64,17 +109,39 @@ func (r Router) InitRoutes(api *echo.Group) {
 	users.Use(r.authMiddleware.TokenCheckMiddleware())
 	{
 		users.GET("/me", r.userHandler.GetUser)
+		users.PATCH("/me", r.userHandler.UpdateUser)
+		users.POST("/me/calendar-token", r.userHandler.RotateCalendarToken)
+		users.DELETE("/me/calendar-token", r.userHandler.RevokeCalendarToken)
+		users.POST("/me/export", r.exportHandler.CreateExportJob)
+		users.GET("/me/export/:id", r.exportHandler.GetExportJobByID)
 	}
 
+	api.GET("/calendar/:token", r.calendarHandler.GetCalendarFeed)
+
 	goals := api.Group("/goals")
 	goals.Use(r.authMiddleware.TokenCheckMiddleware())
 	{
 		goals.GET("/", r.goalHandler.GetGoals)
 		goals.GET("/:id", r.goalHandler.GetGoalByID)
 		goals.GET("/:id/tasks", r.taskHandler.GetTasksByGoalID)
+		goals.GET("/:id/streak", r.taskHandler.GetGoalStreak)
 		goals.POST("/", r.goalHandler.CreateGoal)
 		goals.PATCH("/", r.goalHandler.UpdateGoal)
 		goals.DELETE("/:id", r.goalHandler.DeleteGoalByID)
+		goals.GET("/:id/members", r.goalMemberHandler.GetGoalMembers)
+		goals.PATCH("/:id/members/:user_id", r.goalMemberHandler.UpdateGoalMember)
+		goals.DELETE("/:id/members/:user_id", r.goalMemberHandler.RemoveGoalMember)
+		goals.GET("/:id/invitations", r.goalMemberHandler.GetGoalInvitations)
+		goals.POST("/:id/invitations", r.goalMemberHandler.InviteGoalMember)
+		goals.DELETE("/:id/invitations/:invitation_id", r.goalMemberHandler.DeleteGoalInvitation)
+	}
+
+	invitations := api.Group("/invitations")
+	invitations.Use(r.authMiddleware.TokenCheckMiddleware())
+	{
+		invitations.GET("/", r.goalMemberHandler.GetReceivedInvitations)
+		invitations.POST("/:id/accept", r.goalMemberHandler.AcceptInvitation)
+		invitations.POST("/:id/decline", r.goalMemberHandler.DeclineInvitation)
 	}
 
 	recurringTasksTemplates := api.Group("/recurring-tasks-templates")
@@ -82,6 +149,7 @@ func (r Router) InitRoutes(api *echo.Group) {
 	{
 		recurringTasksTemplates.GET("/", r.recurringTasksTemplateHandler.GetRecurringTasksTemplates)
 		recurringTasksTemplates.GET("/:id", r.recurringTasksTemplateHandler.GetRecurringTasksTemplateByID)
+		recurringTasksTemplates.GET("/:id/streak", r.taskHandler.GetRecurringTasksTemplateStreak)
 		recurringTasksTemplates.POST("/", r.recurringTasksTemplateHandler.CreateRecurringTasksTemplate)
 		recurringTasksTemplates.PATCH("/:id", r.recurringTasksTemplateHandler.UpdateRecurringTasksTemplateByID)
 		recurringTasksTemplates.DELETE("/:id", r.recurringTasksTemplateHandler.DeleteRecurringTasksTemplateByID)
@@ -92,12 +160,91 @@ func (r Router) InitRoutes(api *echo.Group) {
 	{
 		tasks.GET("/", r.taskHandler.GetTasks)
 		tasks.GET("/inbox", r.taskHandler.GetInboxTasks)
+		tasks.GET("/assigned", r.taskHandler.GetAssignedTasks)
 		tasks.GET("/period", r.taskHandler.GetTasksByPeriod)
 		tasks.GET("/analyze", r.taskHandler.AnalyzeForToday)
+		tasks.GET("/search", r.taskHandler.SearchTasks)
+		tasks.GET("/overdue", r.taskHandler.GetOverdueTasks)
 		tasks.GET("/:id", r.taskHandler.GetTaskByID)
 		tasks.POST("/", r.taskHandler.CreateTask)
 		tasks.PATCH("/:id", r.taskHandler.UpdateTask)
 		tasks.PATCH("/:id/complete", r.taskHandler.CompleteTask)
+		tasks.PATCH("/:id/uncomplete", r.taskHandler.UncompleteTask)
 		tasks.DELETE("/:id", r.taskHandler.DeleteTaskByID)
+		tasks.GET("/:id/items", r.taskChecklistItemHandler.GetTaskChecklistItems)
+		tasks.POST("/:id/items", r.taskChecklistItemHandler.CreateTaskChecklistItem)
+		tasks.PATCH("/:id/items/:item_id", r.taskChecklistItemHandler.UpdateTaskChecklistItem)
+		tasks.DELETE("/:id/items/:item_id", r.taskChecklistItemHandler.DeleteTaskChecklistItem)
+		tasks.GET("/:id/comments", r.taskCommentHandler.GetTaskComments)
+		tasks.POST("/:id/comments", r.taskCommentHandler.CreateTaskComment)
+		tasks.PATCH("/:id/comments/:comment_id", r.taskCommentHandler.UpdateTaskComment)
+		tasks.DELETE("/:id/comments/:comment_id", r.taskCommentHandler.DeleteTaskComment)
+
+		tasks.GET("/:id/attachments", r.taskAttachmentHandler.GetTaskAttachments)
+		tasks.POST("/:id/attachments", r.taskAttachmentHandler.CreateTaskAttachment)
+		tasks.GET("/:id/attachments/:attachment_id", r.taskAttachmentHandler.DownloadTaskAttachment)
+		tasks.DELETE("/:id/attachments/:attachment_id", r.taskAttachmentHandler.DeleteTaskAttachment)
+
+		tasks.GET("/:id/dependencies", r.taskDependencyHandler.GetTaskDependencies)
+		tasks.POST("/:id/dependencies", r.taskDependencyHandler.AddTaskDependency)
+		tasks.DELETE("/:id/dependencies/:blocker_id", r.taskDependencyHandler.RemoveTaskDependency)
+		tasks.GET("/:id/activity", r.taskHandler.GetTaskActivity)
+	}
+
+	tags := api.Group("/tags")
+	tags.Use(r.authMiddleware.TokenCheckMiddleware())
+	{
+		tags.GET("/", r.tagHandler.GetTags)
+		tags.GET("/:id", r.tagHandler.GetTagByID)
+		tags.POST("/", r.tagHandler.CreateTag)
+		tags.PATCH("/:id", r.tagHandler.UpdateTag)
+		tags.DELETE("/:id", r.tagHandler.DeleteTagByID)
+	}
+
+	imports := api.Group("/import")
+	imports.Use(r.authMiddleware.TokenCheckMiddleware())
+	{
+		imports.POST("/ics", r.importHandler.ImportIcs)
+		imports.POST("/csv", r.importHandler.ImportCsv)
+		imports.GET("/:id", r.importHandler.GetImportJobByID)
+	}
+
+	analytics := api.Group("/analytics")
+	analytics.Use(r.authMiddleware.TokenCheckMiddleware())
+	{
+		analytics.GET("/", r.analyticsHandler.GetAnalytics)
+	}
+
+	habits := api.Group("/habits")
+	habits.Use(r.authMiddleware.TokenCheckMiddleware())
+	{
+		habits.GET("/", r.habitHandler.GetHabits)
+		habits.GET("/:id", r.habitHandler.GetHabitByID)
+		habits.POST("/:id/check-ins", r.habitHandler.CheckInHabit)
+		habits.DELETE("/:id/check-ins/:date", r.habitHandler.UndoHabitCheckIn)
+	}
+
+	reviews := api.Group("/reviews")
+	reviews.Use(r.authMiddleware.TokenCheckMiddleware())
+	{
+		reviews.GET("/", r.weeklyReviewHandler.GetWeeklyReview)
+	}
+
+	notifications := api.Group("/notifications")
+	notifications.Use(r.authMiddleware.TokenCheckMiddleware())
+	{
+		notifications.GET("/", r.notificationHandler.GetNotifications)
+		notifications.PATCH("/:id/read", r.notificationHandler.MarkNotificationAsRead)
+	}
+
+	webhooks := api.Group("/webhooks")
+	webhooks.Use(r.authMiddleware.TokenCheckMiddleware())
+	{
+		webhooks.GET("/", r.webhookHandler.GetWebhooks)
+		webhooks.GET("/:id", r.webhookHandler.GetWebhookByID)
+		webhooks.GET("/:id/deliveries", r.webhookHandler.GetWebhookDeliveries)
+		webhooks.POST("/", r.webhookHandler.CreateWebhook)
+		webhooks.PATCH("/:id", r.webhookHandler.UpdateWebhook)
+		webhooks.DELETE("/:id", r.webhookHandler.DeleteWebhookByID)
 	}
 }
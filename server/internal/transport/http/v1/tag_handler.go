@@ -0,0 +1,208 @@
+package v1
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type TagHandler struct {
+	service domain.TagService
+}
+
+func NewTagHandler(service domain.TagService) *TagHandler {
+	return &TagHandler{
+		service: service,
+	}
+}
+
+// GetTags godoc
+// @Summary      get tags
+// @Description  get all tags of user
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.ListTagsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tags/ [get]
+func (h *TagHandler) GetTags(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	tags, err := h.service.ListTags(c.Request().Context(), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting tags", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListTagsResponse(tags))
+}
+
+// GetTagByID godoc
+// @Summary      get tag by :id
+// @Description  get tag by :id
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Tag ID"
+// @Success      200  {object}  dto.TagResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /tags/{id} [get]
+func (h *TagHandler) GetTagByID(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	tag, err := h.service.GetTagByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find tag with provided id", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToTagResponse(tag))
+}
+
+// CreateTag godoc
+// @Summary      create new tag
+// @Description  create new tag with unique title
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        input body dto.CreateTagRequest true "Tag Info"
+// @Success      201  {object}  dto.TagResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tags/ [post]
+func (h *TagHandler) CreateTag(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	var request dto.CreateTagRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	tag, err := h.service.CreateTag(c.Request().Context(), domain.CreateTagInput{
+		UserID: int32(claims.ID),
+		Title:  request.Title,
+		Color:  request.Color,
+	})
+	if err != nil {
+		slog.Error("failed on creating tag", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToTagResponse(tag))
+}
+
+// UpdateTag godoc
+// @Summary      update tag by :id
+// @Description  update existing tag by :id
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Tag ID"
+// @Param        input body dto.UpdateTagRequest true "New Tag Info"
+// @Success      200  {object}  dto.TagResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tags/{id} [patch]
+func (h *TagHandler) UpdateTag(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	tagId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.UpdateTagRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	_, err = h.service.GetTagByID(c.Request().Context(), int64(tagId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find tag with provided id", "error": err.Error()})
+	}
+
+	tag, err := h.service.UpdateTag(c.Request().Context(), domain.UpdateTagInput{
+		ID:     int64(tagId),
+		UserID: int32(claims.ID),
+		Title:  request.Title,
+		Color:  request.Color,
+	})
+	if err != nil {
+		slog.Error("failed on updating tag", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToTagResponse(tag))
+}
+
+// DeleteTagByID godoc
+// @Summary      delete tag by :id
+// @Description  delete tag by :id, tasks and recurring tasks templates lose it
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Tag ID"
+// @Success      200  {object}  map[string]string "tag has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /tags/{id} [delete]
+func (h *TagHandler) DeleteTagByID(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	err = h.service.DeleteTagByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on deleting tag by id", "error", err)
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "tag not found", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "tag has been removed"})
+}
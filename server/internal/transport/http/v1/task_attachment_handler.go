@@ -0,0 +1,267 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/labstack/echo/v4"
+)
+
+// multipartOverhead leaves room for form boundaries and headers around the uploaded file.
+const multipartOverhead = 1 << 20
+
+type TaskAttachmentHandler struct {
+	service           domain.TaskAttachmentService
+	taskService       domain.TaskService
+	goalMemberService domain.GoalMemberService
+	maxSizeBytes      int64
+}
+
+func NewTaskAttachmentHandler(service domain.TaskAttachmentService, taskService domain.TaskService, goalMemberService domain.GoalMemberService, maxSizeBytes int64) *TaskAttachmentHandler {
+	return &TaskAttachmentHandler{
+		service:           service,
+		taskService:       taskService,
+		goalMemberService: goalMemberService,
+		maxSizeBytes:      maxSizeBytes,
+	}
+}
+
+// GetTaskAttachments godoc
+// @Summary      get attachments of task by :id
+// @Description  get attachments of task by :id oldest first, available to everyone with access to the task
+// @Tags         task-attachments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Success      200  {object}  dto.ListTaskAttachmentsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/attachments [get]
+func (h *TaskAttachmentHandler) GetTaskAttachments(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	attachments, err := h.service.ListTaskAttachments(c.Request().Context(), int32(taskId))
+	if err != nil {
+		slog.Error("failed on getting task attachments", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListTaskAttachmentsResponse(int32(taskId), attachments))
+}
+
+// CreateTaskAttachment godoc
+// @Summary      attach file to task by :id
+// @Description  upload file to task by :id, size of file and number of attachments per task are limited by server config
+// @Tags         task-attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        file formData file true "Attached file"
+// @Success      201  {object}  dto.TaskAttachmentResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      413  {object}  map[string]string "Request Entity Too Large"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/attachments [post]
+func (h *TaskAttachmentHandler) CreateTaskAttachment(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	dbTask, err := h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for attaching file")
+	}
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, h.maxSizeBytes+multipartOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"message": "file is too large", "error": domain.TaskAttachmentTooLargeError.Error()})
+		}
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	fileName := filepath.Base(fileHeader.Filename)
+	if fileName == "." || fileName == string(filepath.Separator) || len(fileName) > 255 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": fmt.Sprintf("invalid file name %q", fileHeader.Filename)})
+	}
+
+	contentType := fileHeader.Header.Get(echo.HeaderContentType)
+	if contentType == "" {
+		contentType = echo.MIMEOctetStream
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+	defer file.Close()
+
+	attachment, err := h.service.CreateTaskAttachment(c.Request().Context(), domain.CreateTaskAttachmentInput{
+		TaskID:      int32(taskId),
+		UserID:      int32(claims.ID),
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        fileHeader.Size,
+		Content:     file,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.TaskAttachmentTooLargeError):
+			return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"message": "file is too large", "error": err.Error()})
+		case errors.Is(err, domain.TaskAttachmentLimitError):
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+		slog.Error("failed on creating task attachment", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToTaskAttachmentResponse(attachment))
+}
+
+// DownloadTaskAttachment godoc
+// @Summary      download attachment by :attachment_id
+// @Description  download content of attachment by :attachment_id, available to everyone with access to the task
+// @Tags         task-attachments
+// @Produce      octet-stream
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        attachment_id path int64 true "Attachment ID"
+// @Success      200  {file}    file
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/attachments/{attachment_id} [get]
+func (h *TaskAttachmentHandler) DownloadTaskAttachment(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	attachmentId, err := strconv.Atoi(c.Param("attachment_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	attachment, content, err := h.service.OpenTaskAttachment(c.Request().Context(), int64(attachmentId), int32(taskId))
+	if errors.Is(err, domain.TaskAttachmentNotFoundError) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find attachment with provided id", "error": err.Error()})
+	}
+	if err != nil {
+		slog.Error("failed on opening task attachment", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+	defer content.Close()
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(attachment.SizeBytes, 10))
+	c.Response().Header().Set("X-Content-Type-Options", "nosniff")
+
+	return c.Stream(http.StatusOK, attachment.ContentType, io.LimitReader(content, attachment.SizeBytes))
+}
+
+// DeleteTaskAttachment godoc
+// @Summary      delete attachment by :attachment_id
+// @Description  delete attachment of task by :attachment_id, its file is removed from storage in background
+// @Tags         task-attachments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        attachment_id path int64 true "Attachment ID"
+// @Success      200  {object}  map[string]string "attachment has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/attachments/{attachment_id} [delete]
+func (h *TaskAttachmentHandler) DeleteTaskAttachment(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	attachmentId, err := strconv.Atoi(c.Param("attachment_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	dbTask, err := h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for deleting attachment")
+	}
+
+	err = h.service.DeleteTaskAttachmentByID(c.Request().Context(), int64(attachmentId), int32(taskId))
+	if errors.Is(err, domain.TaskAttachmentNotFoundError) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find attachment with provided id", "error": err.Error()})
+	}
+	if err != nil {
+		slog.Error("failed on deleting task attachment by id", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "attachment has been removed"})
+}
@@ -0,0 +1,246 @@
+package v1
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type TaskChecklistItemHandler struct {
+	service           domain.TaskChecklistItemService
+	taskService       domain.TaskService
+	goalMemberService domain.GoalMemberService
+}
+
+func NewTaskChecklistItemHandler(service domain.TaskChecklistItemService, taskService domain.TaskService, goalMemberService domain.GoalMemberService) *TaskChecklistItemHandler {
+	return &TaskChecklistItemHandler{
+		service:           service,
+		taskService:       taskService,
+		goalMemberService: goalMemberService,
+	}
+}
+
+// GetTaskChecklistItems godoc
+// @Summary      get checklist items of task by :id
+// @Description  get ordered checklist items of task by :id with progress
+// @Tags         task-checklist-items
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Success      200  {object}  dto.ListTaskChecklistItemsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/items [get]
+func (h *TaskChecklistItemHandler) GetTaskChecklistItems(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	items, err := h.service.ListTaskChecklistItems(c.Request().Context(), int32(taskId))
+	if err != nil {
+		slog.Error("failed on getting task checklist items", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListTaskChecklistItemsResponse(int32(taskId), items))
+}
+
+// CreateTaskChecklistItem godoc
+// @Summary      create checklist item in task by :id
+// @Description  append new checklist item to the end of task's checklist
+// @Tags         task-checklist-items
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        input body dto.CreateTaskChecklistItemRequest true "Checklist Item Info"
+// @Success      201  {object}  dto.TaskChecklistItemResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/items [post]
+func (h *TaskChecklistItemHandler) CreateTaskChecklistItem(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.CreateTaskChecklistItemRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	dbTask, err := h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for creating checklist item")
+	}
+
+	item, err := h.service.CreateTaskChecklistItem(c.Request().Context(), domain.CreateTaskChecklistItemInput{
+		TaskID: int32(taskId),
+		UserID: int32(claims.ID),
+		Title:  request.Title,
+	})
+	if err != nil {
+		slog.Error("failed on creating task checklist item", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToTaskChecklistItemResponse(item))
+}
+
+// UpdateTaskChecklistItem godoc
+// @Summary      update checklist item by :item_id
+// @Description  update title, completion or position of checklist item by :item_id
+// @Tags         task-checklist-items
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        item_id path int64 true "Checklist Item ID"
+// @Param        input body dto.UpdateTaskChecklistItemRequest true "New Checklist Item Info"
+// @Success      200  {object}  dto.TaskChecklistItemResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/items/{item_id} [patch]
+func (h *TaskChecklistItemHandler) UpdateTaskChecklistItem(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	itemId, err := strconv.Atoi(c.Param("item_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.UpdateTaskChecklistItemRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	dbTask, err := h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for updating checklist item")
+	}
+
+	_, err = h.service.GetTaskChecklistItemByID(c.Request().Context(), int64(itemId), int32(taskId))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find checklist item with provided id", "error": err.Error()})
+	}
+
+	item, err := h.service.UpdateTaskChecklistItem(c.Request().Context(), domain.UpdateTaskChecklistItemInput{
+		ID:       int64(itemId),
+		TaskID:   int32(taskId),
+		Title:    request.Title,
+		IsDone:   request.IsDone,
+		Position: request.Position,
+	})
+	if err != nil {
+		slog.Error("failed on updating task checklist item", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToTaskChecklistItemResponse(item))
+}
+
+// DeleteTaskChecklistItem godoc
+// @Summary      delete checklist item by :item_id
+// @Description  delete checklist item by :item_id
+// @Tags         task-checklist-items
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        item_id path int64 true "Checklist Item ID"
+// @Success      200  {object}  map[string]string "checklist item has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /tasks/{id}/items/{item_id} [delete]
+func (h *TaskChecklistItemHandler) DeleteTaskChecklistItem(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	itemId, err := strconv.Atoi(c.Param("item_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	dbTask, err := h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for deleting checklist item")
+	}
+
+	err = h.service.DeleteTaskChecklistItemByID(c.Request().Context(), int64(itemId), int32(taskId))
+	if err != nil {
+		slog.Error("failed on deleting task checklist item by id", "error", err)
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "checklist item not found", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "checklist item has been removed"})
+}
@@ -0,0 +1,218 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type TaskCommentHandler struct {
+	service     domain.TaskCommentService
+	taskService domain.TaskService
+}
+
+func NewTaskCommentHandler(service domain.TaskCommentService, taskService domain.TaskService) *TaskCommentHandler {
+	return &TaskCommentHandler{
+		service:     service,
+		taskService: taskService,
+	}
+}
+
+// GetTaskComments godoc
+// @Summary      get comments of task by :id
+// @Description  get comments of task by :id oldest first, available to everyone with access to the task
+// @Tags         task-comments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Success      200  {object}  dto.ListTaskCommentsResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/comments [get]
+func (h *TaskCommentHandler) GetTaskComments(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	comments, err := h.service.ListTaskComments(c.Request().Context(), int32(taskId))
+	if err != nil {
+		slog.Error("failed on getting task comments", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListTaskCommentsResponse(int32(taskId), comments))
+}
+
+// CreateTaskComment godoc
+// @Summary      comment task by :id
+// @Description  add comment to task by :id, available to everyone with access to the task
+// @Tags         task-comments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        input body dto.UpdateTaskCommentRequest true "Comment Info"
+// @Success      201  {object}  dto.TaskCommentResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/comments [post]
+func (h *TaskCommentHandler) CreateTaskComment(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.UpdateTaskCommentRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	_, err = h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	comment, err := h.service.CreateTaskComment(c.Request().Context(), domain.CreateTaskCommentInput{
+		TaskID: int32(taskId),
+		UserID: int32(claims.ID),
+		Body:   request.Body,
+	})
+	if err != nil {
+		slog.Error("failed on creating task comment", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToTaskCommentResponse(comment))
+}
+
+// UpdateTaskComment godoc
+// @Summary      update comment by :comment_id
+// @Description  update body of own comment by :comment_id
+// @Tags         task-comments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        comment_id path int64 true "Comment ID"
+// @Param        input body dto.UpdateTaskCommentRequest true "New Comment Info"
+// @Success      200  {object}  dto.TaskCommentResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/comments/{comment_id} [patch]
+func (h *TaskCommentHandler) UpdateTaskComment(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	commentId, err := strconv.Atoi(c.Param("comment_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.UpdateTaskCommentRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	comment, err := h.service.UpdateTaskComment(c.Request().Context(), domain.UpdateTaskCommentInput{
+		ID:     int64(commentId),
+		TaskID: int32(taskId),
+		UserID: int32(claims.ID),
+		Body:   request.Body,
+	})
+	if errors.Is(err, domain.TaskCommentNotFoundError) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find comment with provided id", "error": err.Error()})
+	}
+	if err != nil {
+		slog.Error("failed on updating task comment", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToTaskCommentResponse(comment))
+}
+
+// DeleteTaskComment godoc
+// @Summary      delete comment by :comment_id
+// @Description  delete own comment by :comment_id
+// @Tags         task-comments
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        comment_id path int64 true "Comment ID"
+// @Success      200  {object}  map[string]string "comment has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/comments/{comment_id} [delete]
+func (h *TaskCommentHandler) DeleteTaskComment(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	commentId, err := strconv.Atoi(c.Param("comment_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	err = h.service.DeleteTaskCommentByID(c.Request().Context(), int64(commentId), int32(taskId), int32(claims.ID))
+	if errors.Is(err, domain.TaskCommentNotFoundError) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find comment with provided id", "error": err.Error()})
+	}
+	if err != nil {
+		slog.Error("failed on deleting task comment by id", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "comment has been removed"})
+}
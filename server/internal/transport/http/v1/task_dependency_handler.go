@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type TaskDependencyHandler struct {
+	service           domain.TaskDependencyService
+	taskService       domain.TaskService
+	goalMemberService domain.GoalMemberService
+}
+
+func NewTaskDependencyHandler(service domain.TaskDependencyService, taskService domain.TaskService, goalMemberService domain.GoalMemberService) *TaskDependencyHandler {
+	return &TaskDependencyHandler{
+		service:           service,
+		taskService:       taskService,
+		goalMemberService: goalMemberService,
+	}
+}
+
+// GetTaskDependencies godoc
+// @Summary      get tasks blocking task by :id
+// @Description  get tasks that task by :id is blocked by, unfinished ones first, available to everyone with access to the task
+// @Tags         task-dependencies
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Success      200  {object}  dto.ListTaskBlockersResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/dependencies [get]
+func (h *TaskDependencyHandler) GetTaskDependencies(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	blockers, err := h.service.ListTaskBlockers(c.Request().Context(), int32(taskId))
+	if err != nil {
+		slog.Error("failed on getting task blockers", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListTaskBlockersResponse(int64(taskId), blockers))
+}
+
+// AddTaskDependency godoc
+// @Summary      block task by :id with another task
+// @Description  make task by :id blocked by another task of the same owner, task can't be completed until its blockers are; dependencies forming a cycle are refused
+// @Tags         task-dependencies
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        input body dto.AddTaskDependencyRequest true "Blocking Task Info"
+// @Success      201  {object}  dto.ListTaskBlockersResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/dependencies [post]
+func (h *TaskDependencyHandler) AddTaskDependency(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.AddTaskDependencyRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	dbTask, err := h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for adding dependency")
+	}
+
+	blocker, err := h.taskService.GetTaskByID(c.Request().Context(), request.BlockedByTaskID, int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find blocking task with provided id", "error": err.Error()})
+	}
+
+	err = h.service.AddTaskDependency(c.Request().Context(), *dbTask, *blocker)
+	if errors.Is(err, domain.InvalidTaskDependencyError) || errors.Is(err, domain.TaskDependencyCycleError) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+	if err != nil {
+		slog.Error("failed on adding task dependency", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	blockers, err := h.service.ListTaskBlockers(c.Request().Context(), int32(taskId))
+	if err != nil {
+		slog.Error("failed on getting task blockers", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToListTaskBlockersResponse(int64(taskId), blockers))
+}
+
+// RemoveTaskDependency godoc
+// @Summary      unblock task by :id from task by :blocker_id
+// @Description  remove dependency of task by :id on task by :blocker_id
+// @Tags         task-dependencies
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Param        blocker_id path int64 true "Blocking Task ID"
+// @Success      200  {object}  map[string]string "dependency has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/dependencies/{blocker_id} [delete]
+func (h *TaskDependencyHandler) RemoveTaskDependency(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	blockerId, err := strconv.Atoi(c.Param("blocker_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	dbTask, err := h.taskService.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for removing dependency")
+	}
+
+	err = h.service.RemoveTaskDependency(c.Request().Context(), int32(taskId), int32(blockerId))
+	if errors.Is(err, domain.TaskDependencyNotFoundError) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find dependency with provided id", "error": err.Error()})
+	}
+	if err != nil {
+		slog.Error("failed on removing task dependency", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "dependency has been removed"})
+}
@@ -1,10 +1,12 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ali-nur31/mile-do/internal/domain"
@@ -13,24 +15,43 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+const (
+	defaultTasksPageLimit = 50
+	maxTasksPageLimit     = 200
+)
+
 type TaskHandler struct {
-	service domain.TaskService
+	service                       domain.TaskService
+	goalService                   domain.GoalService
+	recurringTasksTemplateService domain.RecurringTasksTemplateService
+	goalMemberService             domain.GoalMemberService
 }
 
-func NewTaskHandler(service domain.TaskService) *TaskHandler {
+func NewTaskHandler(service domain.TaskService, goalService domain.GoalService, recurringTasksTemplateService domain.RecurringTasksTemplateService, goalMemberService domain.GoalMemberService) *TaskHandler {
 	return &TaskHandler{
-		service: service,
+		service:                       service,
+		goalService:                   goalService,
+		recurringTasksTemplateService: recurringTasksTemplateService,
+		goalMemberService:             goalMemberService,
 	}
 }
 
 // GetTasksByGoalID godoc
 // @Summary      get tasks by :goal_id
-// @Description  get tasks by :goal_id
+// @Description  get page of tasks by :goal_id, ordered by priority, completion and newest first, for a shared goal tasks of all members are returned
 // @Tags         tasks
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int64 true "Goal ID"
+// @Param        tag query int false "only tasks with tag by id"
+// @Param        is_done query bool false "only done or undone tasks"
+// @Param        has_time query bool false "only tasks with or without time"
+// @Param        recurring_template_id query int false "only tasks generated by recurring tasks template by id"
+// @Param        scheduled_after query string false "tasks scheduled on or after specific date"
+// @Param        scheduled_before query string false "tasks scheduled on or before specific date"
+// @Param        limit query int false "page size up to 200, all tasks are returned when neither limit nor cursor is set"
+// @Param        cursor query string false "next_cursor from previous page"
 // @Success      200  {object}  dto.ListTasksResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
 // @Failure      400  {object}  map[string]string "Bad Request"
@@ -42,18 +63,26 @@ func (h *TaskHandler) GetTasksByGoalID(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
 	}
 
+	input, err := parseListTasksQueryParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
 	claims, err := GetCurrentClaimsFromCtx(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
 	}
 
-	tasks, err := h.service.ListTasksByGoalID(c.Request().Context(), int32(claims.ID), int32(goalId))
+	input.UserID = int32(claims.ID)
+	input.GoalID = int32(goalId)
+
+	page, err := h.service.ListTasks(c.Request().Context(), input)
 	if err != nil {
 		slog.Error("failed on getting tasks by goal id", "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
 	}
 
-	return c.JSON(http.StatusOK, dto.ToListTasksResponse(tasks))
+	return c.JSON(http.StatusOK, dto.ToListTasksPageResponse(page))
 }
 
 // GetInboxTasks godoc
@@ -82,6 +111,58 @@ func (h *TaskHandler) GetInboxTasks(c echo.Context) error {
 	return c.JSON(http.StatusOK, dto.ToListTasksResponse(tasks))
 }
 
+// GetAssignedTasks godoc
+// @Summary      get assigned tasks
+// @Description  get undone tasks assigned to user, both own ones and ones of shared goals
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.ListTasksResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/assigned [get]
+func (h *TaskHandler) GetAssignedTasks(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	tasks, err := h.service.ListAssignedTasks(c.Request().Context(), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting assigned tasks", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListTasksResponse(tasks))
+}
+
+// GetOverdueTasks godoc
+// @Summary      get overdue tasks
+// @Description  get undone tasks scheduled before today in user's time zone
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.ListTasksResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/overdue [get]
+func (h *TaskHandler) GetOverdueTasks(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	tasks, err := h.service.ListOverdueTasks(c.Request().Context(), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting overdue tasks", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListTasksResponse(tasks))
+}
+
 // GetTasksByPeriod godoc
 // @Summary      get tasks by period
 // @Description  get tasks by period
@@ -89,8 +170,9 @@ func (h *TaskHandler) GetInboxTasks(c echo.Context) error {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        after_date query string false "tasks after specific date"
-// @Param        before_date query string false "tasks before specific date"
+// @Param        after_date query string false "tasks after specific date, defaults to today in user's time zone"
+// @Param        before_date query string false "tasks before specific date, defaults to today in user's time zone"
+// @Param        tag query int false "only tasks with tag by id"
 // @Success      200  {object}  dto.ListTasksResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
 // @Failure      400  {object}  map[string]string "Bad Request"
@@ -99,18 +181,26 @@ func (h *TaskHandler) GetInboxTasks(c echo.Context) error {
 func (h *TaskHandler) GetTasksByPeriod(c echo.Context) error {
 	afterDateParam := c.QueryParam("after_date")
 	beforeDateParam := c.QueryParam("before_date")
-	if afterDateParam == "" || beforeDateParam == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": "at least after_date or before_date must be present"})
+
+	var afterDate, beforeDate time.Time
+	var err error
+	if afterDateParam != "" {
+		afterDate, err = time.Parse(time.DateOnly, afterDateParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, after_date must be in YYYY-MM-DD format", "error": err.Error()})
+		}
 	}
 
-	afterDate, err := time.Parse(time.DateOnly, afterDateParam)
-	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, after_date must be in YYYY-MM-DD format", "error": err.Error()})
+	if beforeDateParam != "" {
+		beforeDate, err = time.Parse(time.DateOnly, beforeDateParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, before_date must be in YYYY-MM-DD format", "error": err.Error()})
+		}
 	}
 
-	beforeDate, err := time.Parse(time.DateOnly, beforeDateParam)
+	tagId, err := parseTagQueryParam(c)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, before_date must be in YYYY-MM-DD format", "error": err.Error()})
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, tag must be integer", "error": err.Error()})
 	}
 
 	claims, err := GetCurrentClaimsFromCtx(c)
@@ -122,6 +212,7 @@ func (h *TaskHandler) GetTasksByPeriod(c echo.Context) error {
 		UserID:     int32(claims.ID),
 		AfterDate:  afterDate,
 		BeforeDate: beforeDate,
+		TagID:      tagId,
 	})
 	if err != nil {
 		slog.Error("failed on getting tasks by period", "error", err)
@@ -133,27 +224,129 @@ func (h *TaskHandler) GetTasksByPeriod(c echo.Context) error {
 
 // GetTasks godoc
 // @Summary      get tasks
-// @Description  get all tasks
+// @Description  get page of all tasks, ordered by priority, completion and newest first
 // @Tags         tasks
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        goal_id query int false "only tasks of goal by id"
+// @Param        tag query int false "only tasks with tag by id"
+// @Param        is_done query bool false "only done or undone tasks"
+// @Param        has_time query bool false "only tasks with or without time"
+// @Param        recurring_template_id query int false "only tasks generated by recurring tasks template by id"
+// @Param        scheduled_after query string false "tasks scheduled on or after specific date"
+// @Param        scheduled_before query string false "tasks scheduled on or before specific date"
+// @Param        limit query int false "page size up to 200, all tasks are returned when neither limit nor cursor is set"
+// @Param        cursor query string false "next_cursor from previous page"
 // @Success      200  {object}  dto.ListTasksResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
 // @Router       /tasks/ [get]
 func (h *TaskHandler) GetTasks(c echo.Context) error {
+	input, err := parseListTasksQueryParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if goalIdParam := c.QueryParam("goal_id"); goalIdParam != "" {
+		goalId, err := strconv.Atoi(goalIdParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, goal_id must be integer", "error": err.Error()})
+		}
+		input.GoalID = int32(goalId)
+	}
+
 	claims, err := GetCurrentClaimsFromCtx(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
 	}
 
-	tasks, err := h.service.ListTasks(c.Request().Context(), int32(claims.ID))
+	input.UserID = int32(claims.ID)
+
+	page, err := h.service.ListTasks(c.Request().Context(), input)
 	if err != nil {
 		slog.Error("failed on getting all tasks", "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
 	}
 
+	return c.JSON(http.StatusOK, dto.ToListTasksPageResponse(page))
+}
+
+// SearchTasks godoc
+// @Summary      search tasks
+// @Description  full-text search over task titles and descriptions, ranked by relevance
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        q query string true "search query"
+// @Param        goal_id query int false "only tasks of goal by id"
+// @Param        is_done query bool false "only done or undone tasks"
+// @Param        after_date query string false "tasks after specific date"
+// @Param        before_date query string false "tasks before specific date"
+// @Success      200  {object}  dto.ListTasksResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/search [get]
+func (h *TaskHandler) SearchTasks(c echo.Context) error {
+	query := strings.TrimSpace(c.QueryParam("q"))
+	if query == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, q is required"})
+	}
+
+	var goalId int
+	var err error
+	if goalIdParam := c.QueryParam("goal_id"); goalIdParam != "" {
+		goalId, err = strconv.Atoi(goalIdParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, goal_id must be integer", "error": err.Error()})
+		}
+	}
+
+	var isDone *bool
+	if isDoneParam := c.QueryParam("is_done"); isDoneParam != "" {
+		parsedIsDone, err := strconv.ParseBool(isDoneParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, is_done must be boolean", "error": err.Error()})
+		}
+		isDone = &parsedIsDone
+	}
+
+	var afterDate, beforeDate time.Time
+	if afterDateParam := c.QueryParam("after_date"); afterDateParam != "" {
+		afterDate, err = time.Parse(time.DateOnly, afterDateParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, after_date must be in YYYY-MM-DD format", "error": err.Error()})
+		}
+	}
+
+	if beforeDateParam := c.QueryParam("before_date"); beforeDateParam != "" {
+		beforeDate, err = time.Parse(time.DateOnly, beforeDateParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, before_date must be in YYYY-MM-DD format", "error": err.Error()})
+		}
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	tasks, err := h.service.SearchTasks(c.Request().Context(), domain.SearchTasksInput{
+		UserID:     int32(claims.ID),
+		Query:      query,
+		GoalID:     int32(goalId),
+		IsDone:     isDone,
+		AfterDate:  afterDate,
+		BeforeDate: beforeDate,
+	})
+	if err != nil {
+		slog.Error("failed on searching tasks", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
 	return c.JSON(http.StatusOK, dto.ToListTasksResponse(tasks))
 }
 
@@ -190,6 +383,45 @@ func (h *TaskHandler) GetTaskByID(c echo.Context) error {
 	return c.JSON(http.StatusOK, dto.ToTaskResponse(task))
 }
 
+// GetTaskActivity godoc
+// @Summary      get activity of task by :id
+// @Description  get history of task by :id newest first, with old and new values of changed fields
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Success      200  {object}  dto.ListTaskActivityResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/activity [get]
+func (h *TaskHandler) GetTaskActivity(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.service.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	activity, err := h.service.ListTaskActivity(c.Request().Context(), int64(taskId))
+	if err != nil {
+		slog.Error("failed on getting task activity", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListTaskActivityResponse(int32(taskId), activity))
+}
+
 // CreateTask godoc
 // @Summary      create new task
 // @Description  create new task
@@ -200,6 +432,7 @@ func (h *TaskHandler) GetTaskByID(c echo.Context) error {
 // @Param        input body dto.CreateTaskRequest true "Task Info"
 // @Success      201  {object}  dto.TaskResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
 // @Failure      400  {object}  map[string]string "Bad Request"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
 // @Router       /tasks/ [post]
@@ -229,17 +462,38 @@ func (h *TaskHandler) CreateTask(c echo.Context) error {
 		}
 	}
 
+	if request.GoalID != 0 {
+		err = h.goalMemberService.AuthorizeGoal(c.Request().Context(), int64(request.GoalID), int32(claims.ID), domain.GoalRoleEditor)
+		if err != nil {
+			return goalMemberErrorResponse(c, err, "failed on authorizing goal for creating task")
+		}
+	}
+
+	if request.AssigneeID != 0 {
+		err = h.goalMemberService.AuthorizeAssignees(c.Request().Context(), request.GoalID, int32(claims.ID), []int32{request.AssigneeID})
+		if err != nil {
+			return goalMemberErrorResponse(c, err, "failed on authorizing assignee for creating task")
+		}
+	}
+
 	task := domain.CreateTaskInput{
 		UserID:          int32(claims.ID),
 		GoalID:          request.GoalID,
 		Title:           request.Title,
+		Description:     request.Description,
 		ScheduledDate:   scheduledDate,
 		ScheduledTime:   scheduledTime,
 		HasTime:         hasTime,
 		DurationMinutes: duration,
+		Priority:        request.Priority,
+		AssigneeID:      request.AssigneeID,
+		TagIDs:          request.TagIDs,
 	}
 
 	outTask, err := h.service.CreateTask(c.Request().Context(), task)
+	if errors.Is(err, domain.InvalidTagError) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
 	if err != nil {
 		slog.Error("failed on creating task", "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
@@ -250,17 +504,20 @@ func (h *TaskHandler) CreateTask(c echo.Context) error {
 
 // UpdateTask godoc
 // @Summary      update task by :id
-// @Description  update existing task by :id
+// @Description  update existing task by :id, tag_ids replaces task tags when provided, scope of a recurring task picks this occurrence only (default), this and following occurrences or all occurrences; setting is_done completes task like the complete endpoint
 // @Tags         tasks
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int64 true "Task ID"
+// @Param        scope query string false "recurrence scope" Enums(this, following, all)
 // @Param        input body dto.UpdateTaskRequest true "New Task Info"
 // @Success      200  {object}  dto.TaskResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
 // @Failure      404  {object}  map[string]string "Not Found"
 // @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      409  {object}  map[string]string "Conflict"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
 // @Router       /tasks/{id} [patch]
 func (h *TaskHandler) UpdateTask(c echo.Context) error {
@@ -274,6 +531,11 @@ func (h *TaskHandler) UpdateTask(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
 	}
 
+	scope, err := parseRecurrenceScopeQueryParam(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
 	var request dto.UpdateTaskRequest
 	if err = c.Bind(&request); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
@@ -289,11 +551,46 @@ func (h *TaskHandler) UpdateTask(c echo.Context) error {
 		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
 	}
 
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for updating")
+	}
+
+	if request.GoalID != 0 && request.GoalID != dbTask.GoalID {
+		err = h.goalMemberService.AuthorizeGoal(c.Request().Context(), int64(request.GoalID), int32(claims.ID), domain.GoalRoleEditor)
+		if err != nil {
+			return goalMemberErrorResponse(c, err, "failed on authorizing goal for updating task")
+		}
+	}
+
+	// omitted assignee keeps the current one, 0 unassigns task
+	assigneeId := dbTask.AssigneeID
+	if request.AssigneeID != nil {
+		assigneeId = *request.AssigneeID
+	}
+
+	if assigneeId != 0 {
+		err = h.goalMemberService.AuthorizeAssignees(c.Request().Context(), request.GoalID, dbTask.UserID, []int32{assigneeId})
+		if err != nil {
+			return goalMemberErrorResponse(c, err, "failed on authorizing assignee for updating task")
+		}
+	}
+
+	// omitted description keeps the current one
+	description := dbTask.Description
+	if request.Description != nil {
+		description = *request.Description
+	}
+
 	// Default to existing values
 	scheduledDate := dbTask.ScheduledDate
 	scheduledTime := dbTask.ScheduledTime
 	hasTime := dbTask.HasTime
 	duration := dbTask.DurationMinutes
+	priority := dbTask.Priority
+	if request.Priority != "" {
+		priority = request.Priority
+	}
 
 	// Only process date/time if provided
 	if request.ScheduledDateTime != "" {
@@ -307,17 +604,28 @@ func (h *TaskHandler) UpdateTask(c echo.Context) error {
 
 	outTask, err := h.service.UpdateTask(c.Request().Context(), *dbTask, domain.UpdateTaskInput{
 		ID:              int64(taskId),
-		UserID:          int32(claims.ID),
+		UserID:          dbTask.UserID,
 		GoalID:          request.GoalID,
 		Title:           request.Title,
+		Description:     description,
 		IsDone:          request.IsDone,
 		ScheduledDate:   scheduledDate,
 		ScheduledTime:   scheduledTime,
 		HasTime:         hasTime,
 		DurationMinutes: duration,
 		RescheduleCount: dbTask.RescheduleCount,
+		Priority:        priority,
+		AssigneeID:      assigneeId,
+		TagIDs:          request.TagIDs,
+		Scope:           scope,
 	})
 
+	if errors.Is(err, domain.TaskBlockedError) {
+		return c.JSON(http.StatusConflict, map[string]string{"message": "task is blocked", "error": err.Error()})
+	}
+	if errors.Is(err, domain.InvalidTagError) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
 	if err != nil {
 		slog.Error("failed on updating task", "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
@@ -328,16 +636,19 @@ func (h *TaskHandler) UpdateTask(c echo.Context) error {
 
 // CompleteTask godoc
 // @Summary      complete task by :id
-// @Description  complete existing task by :id
+// @Description  complete existing task by :id, optionally with all of its checklist items; task blocked by unfinished tasks is refused with 409
 // @Tags         tasks
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int64 true "Task ID"
+// @Param        with_items query bool false "also complete all checklist items of the task"
 // @Success      200  {object}  dto.TaskResponse
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
 // @Failure      404  {object}  map[string]string "Not Found"
 // @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      409  {object}  map[string]string "Conflict"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
 // @Router       /tasks/{id}/complete [patch]
 func (h *TaskHandler) CompleteTask(c echo.Context) error {
@@ -351,12 +662,31 @@ func (h *TaskHandler) CompleteTask(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
 	}
 
-	_, err = h.service.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	withItems := false
+	if withItemsParam := c.QueryParam("with_items"); withItemsParam != "" {
+		withItems, err = strconv.ParseBool(withItemsParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, with_items must be boolean", "error": err.Error()})
+		}
+	}
+
+	dbTask, err := h.service.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
 	if err != nil {
 		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
 	}
 
-	outTask, err := h.service.CompleteTask(c.Request().Context(), int32(claims.ID), int64(taskId))
+	// assignee may complete task even with viewer role in goal
+	if dbTask.AssigneeID != int32(claims.ID) {
+		err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+		if err != nil {
+			return goalMemberErrorResponse(c, err, "failed on authorizing task for completing")
+		}
+	}
+
+	outTask, err := h.service.CompleteTask(c.Request().Context(), dbTask.UserID, int64(taskId), withItems)
+	if errors.Is(err, domain.TaskBlockedError) {
+		return c.JSON(http.StatusConflict, map[string]string{"message": "task is blocked", "error": err.Error()})
+	}
 	if err != nil {
 		slog.Error("failed on completing task", "error", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
@@ -365,9 +695,134 @@ func (h *TaskHandler) CompleteTask(c echo.Context) error {
 	return c.JSON(http.StatusOK, dto.ToTaskResponse(outTask))
 }
 
+// UncompleteTask godoc
+// @Summary      uncomplete task by :id
+// @Description  reopen completed task by :id and clear its completion time, for a template repeating after completion its next occurrence is removed
+// @Tags         tasks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Task ID"
+// @Success      200  {object}  dto.TaskResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /tasks/{id}/uncomplete [patch]
+func (h *TaskHandler) UncompleteTask(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	taskId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	dbTask, err := h.service.GetTaskByID(c.Request().Context(), int64(taskId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find task with provided id", "error": err.Error()})
+	}
+
+	if dbTask.AssigneeID != int32(claims.ID) {
+		err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+		if err != nil {
+			return goalMemberErrorResponse(c, err, "failed on authorizing task for uncompleting")
+		}
+	}
+
+	outTask, err := h.service.UncompleteTask(c.Request().Context(), *dbTask)
+	if err != nil {
+		slog.Error("failed on uncompleting task", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToTaskResponse(outTask))
+}
+
+// GetGoalStreak godoc
+// @Summary      get streak of goal by :id
+// @Description  get current and longest streak of consecutive days in user's time zone on which a task of goal was completed, on shared goal completions of all members count
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Goal ID"
+// @Success      200  {object}  dto.StreakResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /goals/{id}/streak [get]
+func (h *TaskHandler) GetGoalStreak(c echo.Context) error {
+	goalId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	_, err = h.goalService.GetGoalByID(c.Request().Context(), int64(goalId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find goal with provided id", "error": err.Error()})
+	}
+
+	streak, err := h.service.GetGoalStreak(c.Request().Context(), int64(goalId), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting goal streak", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToStreakResponse(streak))
+}
+
+// GetRecurringTasksTemplateStreak godoc
+// @Summary      get streak of recurring tasks template by :id
+// @Description  get current and longest streak of consecutive occurrences completed no later than their day, today's open occurrence doesn't break the streak, on shared template occurrences completed by any member count
+// @Tags         recurring-tasks-templates
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Recurring Tasks Template ID"
+// @Success      200  {object}  dto.StreakResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /recurring-tasks-templates/{id}/streak [get]
+func (h *TaskHandler) GetRecurringTasksTemplateStreak(c echo.Context) error {
+	templateId, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	_, err = h.recurringTasksTemplateService.GetRecurringTasksTemplateByID(c.Request().Context(), int64(templateId), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find recurring tasks template with provided id", "error": err.Error()})
+	}
+
+	streak, err := h.service.GetRecurringTasksTemplateStreak(c.Request().Context(), int64(templateId), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting recurring tasks template streak", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToStreakResponse(streak))
+}
+
 // AnalyzeForToday godoc
 // @Summary      get stats for today
-// @Description  get count of completed tasks over total tasks for today
+// @Description  get count of completed tasks over total tasks for today in user's time zone
 // @Tags         tasks
 // @Accept       json
 // @Produce      json
@@ -393,14 +848,16 @@ func (h *TaskHandler) AnalyzeForToday(c echo.Context) error {
 
 // DeleteTaskByID godoc
 // @Summary      delete task by :id
-// @Description  delete task by :id
+// @Description  delete task by :id, scope of a recurring task picks this occurrence only (default), this and following occurrences or the whole series
 // @Tags         tasks
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path int64 true "Task ID"
+// @Param        scope query string false "recurrence scope" Enums(this, following, all)
 // @Success      201  {string}  map[string]string "Task has been removed"
 // @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      403  {object}  map[string]string "Forbidden"
 // @Failure      404  {object}  map[string]string "Not Found"
 // @Failure      400  {object}  map[string]string "Bad Request"
 // @Failure      500  {object}  map[string]string "Internal Server Error"
@@ -416,15 +873,132 @@ func (h *TaskHandler) DeleteTaskByID(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
 	}
 
-	err = h.service.DeleteTaskByID(c.Request().Context(), int64(id), int32(claims.ID))
+	scope, err := parseRecurrenceScopeQueryParam(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	dbTask, err := h.service.GetTaskByID(c.Request().Context(), int64(id), int32(claims.ID))
 	if err != nil {
-		slog.Error("failed on deleting task by id", "error", err)
 		return c.JSON(http.StatusNotFound, map[string]string{"message": "task not found", "error": err.Error()})
 	}
 
+	err = h.goalMemberService.AuthorizeGoalItemChange(c.Request().Context(), dbTask.GoalID, dbTask.UserID, int32(claims.ID))
+	if err != nil {
+		return goalMemberErrorResponse(c, err, "failed on authorizing task for deletion")
+	}
+
+	if dbTask.RecurringTemplateID != 0 {
+		err = h.service.DeleteRecurringTaskByID(c.Request().Context(), *dbTask, scope)
+	} else {
+		err = h.service.DeleteTaskByID(c.Request().Context(), int64(id), dbTask.UserID)
+	}
+	if errors.Is(err, domain.TaskNotFoundError) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "task not found", "error": err.Error()})
+	}
+	if err != nil {
+		slog.Error("failed on deleting task by id", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
 	return c.JSON(http.StatusOK, map[string]string{"message": "task has been removed"})
 }
 
+func parseListTasksQueryParams(c echo.Context) (domain.ListTasksInput, error) {
+	var input domain.ListTasksInput
+
+	tagId, err := parseTagQueryParam(c)
+	if err != nil {
+		return input, fmt.Errorf("tag must be integer: %w", err)
+	}
+	input.TagID = tagId
+
+	if isDoneParam := c.QueryParam("is_done"); isDoneParam != "" {
+		isDone, err := strconv.ParseBool(isDoneParam)
+		if err != nil {
+			return input, fmt.Errorf("is_done must be boolean: %w", err)
+		}
+		input.IsDone = &isDone
+	}
+
+	if hasTimeParam := c.QueryParam("has_time"); hasTimeParam != "" {
+		hasTime, err := strconv.ParseBool(hasTimeParam)
+		if err != nil {
+			return input, fmt.Errorf("has_time must be boolean: %w", err)
+		}
+		input.HasTime = &hasTime
+	}
+
+	if templateIdParam := c.QueryParam("recurring_template_id"); templateIdParam != "" {
+		templateId, err := strconv.Atoi(templateIdParam)
+		if err != nil {
+			return input, fmt.Errorf("recurring_template_id must be integer: %w", err)
+		}
+		input.RecurringTemplateID = int32(templateId)
+	}
+
+	if scheduledAfterParam := c.QueryParam("scheduled_after"); scheduledAfterParam != "" {
+		input.ScheduledAfter, err = time.Parse(time.DateOnly, scheduledAfterParam)
+		if err != nil {
+			return input, fmt.Errorf("scheduled_after must be in YYYY-MM-DD format: %w", err)
+		}
+	}
+
+	if scheduledBeforeParam := c.QueryParam("scheduled_before"); scheduledBeforeParam != "" {
+		input.ScheduledBefore, err = time.Parse(time.DateOnly, scheduledBeforeParam)
+		if err != nil {
+			return input, fmt.Errorf("scheduled_before must be in YYYY-MM-DD format: %w", err)
+		}
+	}
+
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 1 || limit > maxTasksPageLimit {
+			return input, fmt.Errorf("limit must be integer between 1 and %d", maxTasksPageLimit)
+		}
+		input.Limit = int32(limit)
+	}
+
+	if cursorParam := c.QueryParam("cursor"); cursorParam != "" {
+		input.Cursor, err = dto.DecodeTaskCursor(cursorParam)
+		if err != nil {
+			return input, err
+		}
+
+		if input.Limit == 0 {
+			input.Limit = defaultTasksPageLimit
+		}
+	}
+
+	return input, nil
+}
+
+func parseTagQueryParam(c echo.Context) (int32, error) {
+	tagParam := c.QueryParam("tag")
+	if tagParam == "" {
+		return 0, nil
+	}
+
+	tagId, err := strconv.Atoi(tagParam)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(tagId), nil
+}
+
+func parseRecurrenceScopeQueryParam(c echo.Context) (domain.RecurrenceScope, error) {
+	scope := domain.RecurrenceScope(c.QueryParam("scope"))
+	switch scope {
+	case "":
+		return domain.RecurrenceScopeThis, nil
+	case domain.RecurrenceScopeThis, domain.RecurrenceScopeFollowing, domain.RecurrenceScopeAll:
+		return scope, nil
+	default:
+		return "", fmt.Errorf("scope must be one of: this, following, all")
+	}
+}
+
 func convertDateTimes(startDateTimeString, endDateTimeString string) (time.Time, time.Time, bool, int32, error) {
 	var startDate, startTime time.Time
 	var duration int32 = 15
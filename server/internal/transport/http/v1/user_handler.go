@@ -1,11 +1,13 @@
 package v1
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
 
 	"github.com/ali-nur31/mile-do/internal/domain"
 	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
 	"github.com/labstack/echo/v4"
 )
 
@@ -45,3 +47,121 @@ func (h *UserHandler) GetUser(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, dto.ToGetUserResponse(user))
 }
+
+// UpdateUser godoc
+// @Summary      update user settings
+// @Description  update account settings of user by bearer token, omitted fields keep their values
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        input body dto.UpdateUserRequest true "New User Settings"
+// @Success      200  {object}  dto.GetUserResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /users/me [patch]
+func (h *UserHandler) UpdateUser(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	var request dto.UpdateUserRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	dbUser, err := h.userService.GetUserByID(c.Request().Context(), claims.ID)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find user", "error": err.Error()})
+	}
+
+	input := domain.UpdateUserInput{
+		ID:                 claims.ID,
+		TimeZone:           dbUser.TimeZone,
+		AutoRollover:       dbUser.AutoRollover,
+		EmailNotifications: dbUser.EmailNotifications,
+		EmailAgenda:        dbUser.EmailAgenda,
+	}
+	if request.TimeZone != "" {
+		input.TimeZone = request.TimeZone
+	}
+	if request.AutoRollover != nil {
+		input.AutoRollover = *request.AutoRollover
+	}
+	if request.EmailNotifications != nil {
+		input.EmailNotifications = *request.EmailNotifications
+	}
+	if request.EmailAgenda != nil {
+		input.EmailAgenda = *request.EmailAgenda
+	}
+
+	user, err := h.userService.UpdateUser(c.Request().Context(), input)
+	if err != nil {
+		slog.Error("failed on updating user", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToGetUserResponse(user))
+}
+
+// RotateCalendarToken godoc
+// @Summary      rotate calendar feed token
+// @Description  issue new secret token for iCalendar feed of user, previous feed url stops working
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      201  {object}  dto.CalendarTokenResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /users/me/calendar-token [post]
+func (h *UserHandler) RotateCalendarToken(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	token, err := h.userService.RotateCalendarToken(c.Request().Context(), claims.ID)
+	if err != nil {
+		slog.Error("failed on rotating calendar token", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.CalendarTokenResponse{
+		Token:   token,
+		FeedUrl: fmt.Sprintf("%s://%s/api/v1/calendar/%s.ics", c.Scheme(), c.Request().Host, token),
+	})
+}
+
+// RevokeCalendarToken godoc
+// @Summary      revoke calendar feed token
+// @Description  disable iCalendar feed of user
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]string "calendar token has been revoked"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /users/me/calendar-token [delete]
+func (h *UserHandler) RevokeCalendarToken(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	err = h.userService.RevokeCalendarToken(c.Request().Context(), claims.ID)
+	if err != nil {
+		slog.Error("failed on revoking calendar token", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "calendar token has been revoked"})
+}
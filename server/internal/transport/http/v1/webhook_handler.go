@@ -0,0 +1,267 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/ali-nur31/mile-do/pkg/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookHandler struct {
+	service domain.WebhookService
+}
+
+func NewWebhookHandler(service domain.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		service: service,
+	}
+}
+
+// GetWebhooks godoc
+// @Summary      get webhooks
+// @Description  get all webhooks of user, secrets are never returned
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.ListWebhooksResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /webhooks/ [get]
+func (h *WebhookHandler) GetWebhooks(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	webhooks, err := h.service.ListWebhooks(c.Request().Context(), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on getting webhooks", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListWebhooksResponse(int32(claims.ID), webhooks))
+}
+
+// GetWebhookByID godoc
+// @Summary      get webhook by :id
+// @Description  get webhook by :id
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Webhook ID"
+// @Success      200  {object}  dto.WebhookResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhookByID(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	webhook, err := h.service.GetWebhookByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find webhook with provided id", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToWebhookResponse(webhook))
+}
+
+// CreateWebhook godoc
+// @Summary      create new webhook
+// @Description  register url receiving JSON payloads of chosen task, goal and reminder events, every request carries X-Mile-Do-Signature header with "sha256=" and hex HMAC-SHA256 of raw body keyed by secret; url has to resolve to a public address and redirects are not followed
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        input body dto.CreateWebhookRequest true "Webhook Info"
+// @Success      201  {object}  dto.WebhookResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /webhooks/ [post]
+func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	var request dto.CreateWebhookRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	webhook, err := h.service.CreateWebhook(c.Request().Context(), domain.CreateWebhookInput{
+		UserID: int32(claims.ID),
+		Url:    request.Url,
+		Secret: request.Secret,
+		Events: request.Events,
+	})
+	if err != nil {
+		if errors.Is(err, domain.InvalidWebhookEventError) || errors.Is(err, domain.InvalidWebhookUrlError) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+		slog.Error("failed on creating webhook", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ToWebhookResponse(webhook))
+}
+
+// UpdateWebhook godoc
+// @Summary      update webhook by :id
+// @Description  update webhook by :id, omitted fields keep their values, inactive webhook gets no deliveries
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Webhook ID"
+// @Param        input body dto.UpdateWebhookRequest true "New Webhook Info"
+// @Success      200  {object}  dto.WebhookResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /webhooks/{id} [patch]
+func (h *WebhookHandler) UpdateWebhook(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	var request dto.UpdateWebhookRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	if validateErrors := validator.ValidateStruct(request); validateErrors != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"message": "validation failed", "details": validateErrors})
+	}
+
+	dbWebhook, err := h.service.GetWebhookByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find webhook with provided id", "error": err.Error()})
+	}
+
+	input := domain.UpdateWebhookInput{
+		ID:       dbWebhook.ID,
+		UserID:   int32(claims.ID),
+		Url:      dbWebhook.Url,
+		Secret:   request.Secret,
+		Events:   dbWebhook.Events,
+		IsActive: dbWebhook.IsActive,
+	}
+	if request.Url != "" {
+		input.Url = request.Url
+	}
+	if request.Events != nil {
+		input.Events = request.Events
+	}
+	if request.IsActive != nil {
+		input.IsActive = *request.IsActive
+	}
+
+	webhook, err := h.service.UpdateWebhook(c.Request().Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.InvalidWebhookEventError) || errors.Is(err, domain.InvalidWebhookUrlError) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+		}
+		slog.Error("failed on updating webhook", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToWebhookResponse(webhook))
+}
+
+// DeleteWebhookByID godoc
+// @Summary      delete webhook by :id
+// @Description  delete webhook by :id together with its delivery log, queued deliveries are dropped
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Webhook ID"
+// @Success      200  {object}  map[string]string "webhook has been removed"
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhookByID(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	_, err = h.service.GetWebhookByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find webhook with provided id", "error": err.Error()})
+	}
+
+	err = h.service.DeleteWebhookByID(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		slog.Error("failed on deleting webhook by id", "error", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "webhook has been removed"})
+}
+
+// GetWebhookDeliveries godoc
+// @Summary      get deliveries of webhook by :id
+// @Description  get last 100 deliveries of webhook by :id, newest first, with status, attempts and last error
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path int64 true "Webhook ID"
+// @Success      200  {object}  dto.ListWebhookDeliveriesResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      404  {object}  map[string]string "Not Found"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Router       /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetWebhookDeliveries(c echo.Context) error {
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	deliveries, err := h.service.ListWebhookDeliveries(c.Request().Context(), int64(id), int32(claims.ID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "cannot find webhook with provided id", "error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, dto.ToListWebhookDeliveriesResponse(int64(id), deliveries))
+}
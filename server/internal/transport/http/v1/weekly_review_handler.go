@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+	"github.com/ali-nur31/mile-do/internal/transport/http/v1/dto"
+	"github.com/labstack/echo/v4"
+)
+
+type WeeklyReviewHandler struct {
+	service domain.WeeklyReviewService
+}
+
+func NewWeeklyReviewHandler(service domain.WeeklyReviewService) *WeeklyReviewHandler {
+	return &WeeklyReviewHandler{
+		service: service,
+	}
+}
+
+// GetWeeklyReview godoc
+// @Summary      get weekly review
+// @Description  get summary of finished week: completed tasks per goal, tasks left open, most rescheduled tasks and minutes planned vs done; reviews are generated daily after week ends in user's time zone
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Produce      text/markdown
+// @Security     BearerAuth
+// @Param        week query string false "ISO week in YYYY-Www format, defaults to last week"
+// @Param        format query string false "response format" Enums(json, markdown)
+// @Success      200  {object}  dto.WeeklyReviewResponse
+// @Failure      401  {object}  map[string]string "Unauthorized"
+// @Failure      400  {object}  map[string]string "Bad Request"
+// @Failure      500  {object}  map[string]string "Internal Server Error"
+// @Router       /reviews/ [get]
+func (h *WeeklyReviewHandler) GetWeeklyReview(c echo.Context) error {
+	var weekStart time.Time
+	var err error
+	if weekParam := c.QueryParam("week"); weekParam != "" {
+		weekStart, err = parseIsoWeek(weekParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, week must be in YYYY-Www format", "error": err.Error()})
+		}
+	}
+
+	format := c.QueryParam("format")
+	if format != "" && format != "json" && format != "markdown" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request, format must be json or markdown", "error": "invalid format"})
+	}
+
+	claims, err := GetCurrentClaimsFromCtx(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+	}
+
+	if format == "markdown" {
+		markdown, err := h.service.GetWeeklyReviewMarkdown(c.Request().Context(), int32(claims.ID), weekStart)
+		if err != nil {
+			return h.weeklyReviewError(c, err)
+		}
+
+		return c.Blob(http.StatusOK, "text/markdown; charset=utf-8", markdown)
+	}
+
+	review, err := h.service.GetWeeklyReview(c.Request().Context(), int32(claims.ID), weekStart)
+	if err != nil {
+		return h.weeklyReviewError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.ToWeeklyReviewResponse(review))
+}
+
+func (h *WeeklyReviewHandler) weeklyReviewError(c echo.Context, err error) error {
+	if errors.Is(err, domain.InvalidReviewWeekError) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "bad request", "error": err.Error()})
+	}
+
+	slog.Error("failed on getting weekly review", "error", err)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"message": "internal server error", "error": err.Error()})
+}
+
+// parseIsoWeek returns monday of ISO week like 2026-W07.
+func parseIsoWeek(value string) (time.Time, error) {
+	var year, week int
+	if _, err := fmt.Sscanf(value, "%4d-W%2d", &year, &week); err != nil {
+		return time.Time{}, err
+	}
+
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday())+6)%7)+(week-1)*7)
+
+	if y, w := monday.ISOWeek(); y != year || w != week || len(value) != len("2006-W01") {
+		return time.Time{}, fmt.Errorf("%s is not a valid ISO week", value)
+	}
+
+	return monday, nil
+}
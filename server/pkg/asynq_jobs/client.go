@@ -6,15 +6,19 @@ import (
 )
 
 type Asynq struct {
-	Client *asynq.Client
+	Client    *asynq.Client
+	Inspector *asynq.Inspector
 }
 
 func InitializeAsynqClient(cfg *config.Redis) (*Asynq, error) {
-	asynq := asynq.NewClient(asynq.RedisClientOpt{
+	redisOpt := asynq.RedisClientOpt{
 		Addr:     cfg.Addr,
 		Password: cfg.Password,
 		DB:       cfg.DB,
-	})
+	}
 
-	return &Asynq{Client: asynq}, nil
+	return &Asynq{
+		Client:    asynq.NewClient(redisOpt),
+		Inspector: asynq.NewInspector(redisOpt),
+	}, nil
 }
@@ -0,0 +1,107 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+// LocalStore keeps blobs as files under dir, key slashes become nested directories.
+type LocalStore struct {
+	dir string
+}
+
+func NewLocalStore(dir string) (*LocalStore, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't resolve attachments dir: %w", err)
+	}
+
+	if err = os.MkdirAll(absDir, 0o755); err != nil {
+		return nil, fmt.Errorf("couldn't create attachments dir: %w", err)
+	}
+
+	return &LocalStore{
+		dir: absDir,
+	}, nil
+}
+
+// Put writes content to temporary file first, so a failed upload never leaves a partial blob behind.
+func (s *LocalStore) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	path, err := s.pathInternal(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("couldn't create blob dir: %w", err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("couldn't create blob file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = io.Copy(file, content); err != nil {
+		file.Close()
+		return fmt.Errorf("couldn't write blob file: %w", err)
+	}
+
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("couldn't write blob file: %w", err)
+	}
+
+	if err = os.Rename(file.Name(), path); err != nil {
+		return fmt.Errorf("couldn't move blob file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.pathInternal(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, domain.BlobNotFoundError
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open blob file: %w", err)
+	}
+
+	return file, nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.pathInternal(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("couldn't remove blob file: %w", err)
+	}
+
+	return nil
+}
+
+// pathInternal maps key to file path and refuses keys escaping dir.
+func (s *LocalStore) pathInternal(key string) (string, error) {
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,298 @@
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	dateFormat      = "20060102"
+	localTimeFormat = "20060102T150405"
+	utcTimeFormat   = "20060102T150405Z"
+	maxLineOctets   = 75
+	// years after the latest time of a calendar for which transitions of its time zones are written,
+	// so recurring series keep following DST
+	timeZoneYears = 10
+)
+
+// Calendar is a VCALENDAR object, see RFC 5545.
+type Calendar struct {
+	ProdID   string
+	Name     string
+	TimeZone string
+	Events   []Event
+	Todos    []Todo
+}
+
+// Event is a VEVENT. Times are written in UTC unless TimeZone is set, then as wall clock time with TZID
+// of an IANA time zone, which Encode describes in a VTIMEZONE.
+// Floating is only set by Parse for times without any zone, which belong to the wall clock of the reader.
+type Event struct {
+	UID          string
+	Summary      string
+	Start        time.Time
+	End          time.Time
+	AllDay       bool
+	TimeZone     string
+	Floating     bool
+	RRules       []string
+	ExDates      []time.Time
+	RDates       []time.Time
+	RecurrenceID time.Time
+	Priority     int
+	Created      time.Time
+}
+
+// Todo is a VTODO. Its times follow the same rules as the ones of Event.
+type Todo struct {
+	UID       string
+	Summary   string
+	Due       time.Time
+	AllDay    bool
+	TimeZone  string
+	Floating  bool
+	RRules    []string
+	Completed bool
+	Priority  int
+	Created   time.Time
+}
+
+// Encode renders the calendar as an iCalendar stream with CRLF line endings.
+func (c *Calendar) Encode() []byte {
+	var w writer
+	stamp := time.Now().UTC().Format(utcTimeFormat)
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + c.ProdID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if c.TimeZone != "" {
+		w.line("X-WR-TIMEZONE:" + c.TimeZone)
+	}
+
+	c.writeTimeZones(&w)
+
+	for _, event := range c.Events {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + event.UID)
+		w.line("DTSTAMP:" + stamp)
+		if !event.Created.IsZero() {
+			w.line("CREATED:" + event.Created.UTC().Format(utcTimeFormat))
+		}
+		w.line("SUMMARY:" + escapeText(event.Summary))
+		w.line("DTSTART" + timeProperty(event.AllDay, event.TimeZone, event.Start))
+		if !event.End.IsZero() {
+			w.line("DTEND" + timeProperty(event.AllDay, event.TimeZone, event.End))
+		}
+		for _, rule := range event.RRules {
+			w.line("RRULE:" + rule)
+		}
+		if len(event.ExDates) > 0 {
+			w.line("EXDATE" + timeProperty(event.AllDay, event.TimeZone, event.ExDates...))
+		}
+		if len(event.RDates) > 0 {
+			w.line("RDATE" + timeProperty(event.AllDay, event.TimeZone, event.RDates...))
+		}
+		if event.Priority > 0 {
+			w.line(fmt.Sprintf("PRIORITY:%d", event.Priority))
+		}
+		w.line("END:VEVENT")
+	}
+
+	for _, todo := range c.Todos {
+		w.line("BEGIN:VTODO")
+		w.line("UID:" + todo.UID)
+		w.line("DTSTAMP:" + stamp)
+		if !todo.Created.IsZero() {
+			w.line("CREATED:" + todo.Created.UTC().Format(utcTimeFormat))
+		}
+		w.line("SUMMARY:" + escapeText(todo.Summary))
+		if !todo.Due.IsZero() {
+			w.line("DUE" + timeProperty(todo.AllDay, todo.TimeZone, todo.Due))
+		}
+		for _, rule := range todo.RRules {
+			w.line("RRULE:" + rule)
+		}
+		if todo.Completed {
+			w.line("STATUS:COMPLETED")
+		} else {
+			w.line("STATUS:NEEDS-ACTION")
+		}
+		if todo.Priority > 0 {
+			w.line(fmt.Sprintf("PRIORITY:%d", todo.Priority))
+		}
+		w.line("END:VTODO")
+	}
+
+	w.line("END:VCALENDAR")
+
+	return w.buf.Bytes()
+}
+
+type zoneSpan struct {
+	name     string
+	fromYear int
+	toYear   int
+}
+
+// writeTimeZones writes a VTIMEZONE for every zone referenced by a TZID, see RFC 5545 §3.6.5.
+// Observances are the actual offset transitions of the zone from the earliest year of the calendar's times.
+func (c *Calendar) writeTimeZones(w *writer) {
+	var spans []*zoneSpan
+	index := make(map[string]*zoneSpan)
+
+	add := func(timeZone string, times ...time.Time) {
+		for _, t := range times {
+			if t.IsZero() {
+				continue
+			}
+			span, ok := index[timeZone]
+			if !ok {
+				span = &zoneSpan{name: timeZone, fromYear: t.Year(), toYear: t.Year()}
+				index[timeZone] = span
+				spans = append(spans, span)
+			}
+			span.fromYear = min(span.fromYear, t.Year())
+			span.toYear = max(span.toYear, t.Year())
+		}
+	}
+
+	for _, event := range c.Events {
+		if event.AllDay || event.TimeZone == "" {
+			continue
+		}
+		add(event.TimeZone, event.Start, event.End)
+		add(event.TimeZone, event.ExDates...)
+		add(event.TimeZone, event.RDates...)
+	}
+	for _, todo := range c.Todos {
+		if todo.AllDay || todo.TimeZone == "" {
+			continue
+		}
+		add(todo.TimeZone, todo.Due)
+	}
+
+	for _, span := range spans {
+		loc, err := time.LoadLocation(span.name)
+		if err != nil {
+			// zones come from names of loaded locations, an unknown one has no rules to describe
+			continue
+		}
+
+		from := time.Date(span.fromYear, time.January, 1, 0, 0, 0, 0, loc)
+		to := time.Date(max(span.toYear, time.Now().Year())+timeZoneYears, time.January, 1, 0, 0, 0, 0, loc)
+
+		w.line("BEGIN:VTIMEZONE")
+		w.line("TZID:" + span.name)
+
+		_, offset := from.Zone()
+		writeObservance(w, from, offset)
+		for t := from; ; {
+			_, end := t.ZoneBounds()
+			if end.IsZero() || !end.Before(to) {
+				break
+			}
+			writeObservance(w, end, offset)
+			t = end
+			_, offset = t.Zone()
+		}
+
+		w.line("END:VTIMEZONE")
+	}
+}
+
+// writeObservance writes a STANDARD or DAYLIGHT sub-component for the zone in effect from onset,
+// whose DTSTART is the local time of the offset in effect before.
+func writeObservance(w *writer, onset time.Time, offsetFrom int) {
+	abbreviation, offsetTo := onset.Zone()
+	component := "STANDARD"
+	if onset.IsDST() {
+		component = "DAYLIGHT"
+	}
+
+	w.line("BEGIN:" + component)
+	w.line("DTSTART:" + onset.In(time.FixedZone("", offsetFrom)).Format(localTimeFormat))
+	w.line("TZOFFSETFROM:" + formatOffset(offsetFrom))
+	w.line("TZOFFSETTO:" + formatOffset(offsetTo))
+	if abbreviation != "" {
+		w.line("TZNAME:" + escapeText(abbreviation))
+	}
+	w.line("END:" + component)
+}
+
+// formatOffset renders seconds east of UTC as a UTC-OFFSET value.
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+
+	value := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		value += fmt.Sprintf("%02d", offset%60)
+	}
+
+	return value
+}
+
+// timeProperty renders the parameters and value of a date-time property holding one or more times.
+func timeProperty(allDay bool, timeZone string, times ...time.Time) string {
+	values := make([]string, len(times))
+
+	switch {
+	case allDay:
+		for i, t := range times {
+			values[i] = t.Format(dateFormat)
+		}
+		return ";VALUE=DATE:" + strings.Join(values, ",")
+	case timeZone != "":
+		for i, t := range times {
+			values[i] = t.Format(localTimeFormat)
+		}
+		return ";TZID=" + timeZone + ":" + strings.Join(values, ",")
+	default:
+		for i, t := range times {
+			values[i] = t.UTC().Format(utcTimeFormat)
+		}
+		return ":" + strings.Join(values, ",")
+	}
+}
+
+type writer struct {
+	buf bytes.Buffer
+}
+
+// line writes a content line terminated by CRLF, folded so that no physical line exceeds 75 octets.
+func (w *writer) line(content string) {
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		// never split a multi-byte UTF-8 sequence
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+		// continuation lines start with a space
+		limit = maxLineOctets - 1
+	}
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
+
+func escapeText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(text)
+}
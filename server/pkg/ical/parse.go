@@ -0,0 +1,293 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// Parse reads events and to-dos of the first VCALENDAR in r.
+// Components other than VEVENT and VTODO, including alarms nested in them, are skipped.
+func Parse(r io.Reader) (*Calendar, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var calendar Calendar
+	var event *Event
+	var todo *Todo
+	var duration time.Duration
+	var stack []string
+	found := false
+
+	for number, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		name, params, value, err := parseContentLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", number+1, err)
+		}
+
+		switch name {
+		case "BEGIN":
+			component := strings.ToUpper(value)
+			stack = append(stack, component)
+			if len(stack) == 1 && component == "VCALENDAR" {
+				found = true
+			}
+			if len(stack) == 2 && component == "VEVENT" {
+				event, duration = &Event{}, 0
+			}
+			if len(stack) == 2 && component == "VTODO" {
+				todo = &Todo{}
+			}
+			continue
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", number+1, value)
+			}
+			if len(stack) == 2 && event != nil {
+				if event.End.IsZero() && duration > 0 {
+					event.End = event.Start.Add(duration)
+				}
+				calendar.Events = append(calendar.Events, *event)
+				event = nil
+			}
+			if len(stack) == 2 && todo != nil {
+				calendar.Todos = append(calendar.Todos, *todo)
+				todo = nil
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 && found {
+				return &calendar, nil
+			}
+			continue
+		}
+
+		if len(stack) != 2 {
+			continue
+		}
+
+		switch {
+		case event != nil:
+			err = event.setProperty(name, params, value, &duration)
+		case todo != nil:
+			err = todo.setProperty(name, params, value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", number+1, err)
+		}
+	}
+
+	if !found {
+		return nil, errors.New("no VCALENDAR found")
+	}
+
+	return nil, errors.New("unexpected end of VCALENDAR")
+}
+
+func (e *Event) setProperty(name string, params map[string]string, value string, duration *time.Duration) error {
+	var err error
+
+	switch name {
+	case "UID":
+		e.UID = value
+	case "SUMMARY":
+		e.Summary = unescapeText(value)
+	case "DTSTART":
+		e.Start, e.AllDay, e.TimeZone, e.Floating, err = parseTime(params, value)
+	case "DTEND":
+		e.End, _, _, _, err = parseTime(params, value)
+	case "DURATION":
+		*duration, err = parseDuration(value)
+	case "RRULE":
+		e.RRules = append(e.RRules, value)
+	case "EXDATE":
+		var dates []time.Time
+		dates, err = parseTimes(params, value)
+		e.ExDates = append(e.ExDates, dates...)
+	case "RDATE":
+		// periods are not supported, their start alone would change the meaning
+		if strings.EqualFold(params["VALUE"], "PERIOD") {
+			return nil
+		}
+		var dates []time.Time
+		dates, err = parseTimes(params, value)
+		e.RDates = append(e.RDates, dates...)
+	case "RECURRENCE-ID":
+		e.RecurrenceID, _, _, _, err = parseTime(params, value)
+	case "PRIORITY":
+		e.Priority, _ = strconv.Atoi(value)
+	case "CREATED":
+		e.Created, _, _, _, err = parseTime(params, value)
+	}
+
+	return err
+}
+
+func (t *Todo) setProperty(name string, params map[string]string, value string) error {
+	var err error
+
+	switch name {
+	case "UID":
+		t.UID = value
+	case "SUMMARY":
+		t.Summary = unescapeText(value)
+	case "DUE":
+		t.Due, t.AllDay, t.TimeZone, t.Floating, err = parseTime(params, value)
+	case "DTSTART":
+		// a to-do without due date is scheduled on its start
+		if t.Due.IsZero() {
+			t.Due, t.AllDay, t.TimeZone, t.Floating, err = parseTime(params, value)
+		}
+	case "RRULE":
+		t.RRules = append(t.RRules, value)
+	case "STATUS":
+		t.Completed = strings.EqualFold(value, "COMPLETED")
+	case "PRIORITY":
+		t.Priority, _ = strconv.Atoi(value)
+	case "CREATED":
+		t.Created, _, _, _, err = parseTime(params, value)
+	}
+
+	return err
+}
+
+func unfoldLines(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("couldn't read calendar: %w", err)
+	}
+
+	return lines, nil
+}
+
+// parseContentLine splits `NAME;PARAM=VALUE:value` into its parts, parameter values may be quoted.
+func parseContentLine(line string) (string, map[string]string, string, error) {
+	quoted := false
+	separator := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			separator = i
+			break
+		}
+	}
+	if separator < 0 {
+		return "", nil, "", fmt.Errorf("invalid content line %q", line)
+	}
+
+	parts := strings.Split(line[:separator], ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return strings.ToUpper(parts[0]), params, line[separator+1:], nil
+}
+
+// parseTime parses a DATE or DATE-TIME value. Times with a known TZID are returned in that location,
+// times in UTC in UTC, and floating times as wall clock time in UTC.
+func parseTime(params map[string]string, value string) (time.Time, bool, string, bool, error) {
+	if strings.EqualFold(params["VALUE"], "DATE") || len(value) == len(dateFormat) {
+		t, err := time.Parse(dateFormat, value)
+		if err != nil {
+			return time.Time{}, false, "", false, fmt.Errorf("invalid date %q", value)
+		}
+		return t, true, "", false, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcTimeFormat, value)
+		if err != nil {
+			return time.Time{}, false, "", false, fmt.Errorf("invalid date-time %q", value)
+		}
+		return t, false, "", false, nil
+	}
+
+	if tzid := params["TZID"]; tzid != "" {
+		if loc, err := time.LoadLocation(tzid); err == nil {
+			t, err := time.ParseInLocation(localTimeFormat, value, loc)
+			if err != nil {
+				return time.Time{}, false, "", false, fmt.Errorf("invalid date-time %q", value)
+			}
+			return t, false, tzid, false, nil
+		}
+	}
+
+	// no zone or an unknown one, the wall clock time is the best that can be kept
+	t, err := time.Parse(localTimeFormat, value)
+	if err != nil {
+		return time.Time{}, false, "", false, fmt.Errorf("invalid date-time %q", value)
+	}
+	return t, false, "", true, nil
+}
+
+func parseTimes(params map[string]string, value string) ([]time.Time, error) {
+	var times []time.Time
+	for _, item := range strings.Split(value, ",") {
+		t, _, _, _, err := parseTime(params, item)
+		if err != nil {
+			return nil, err
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
+
+func parseDuration(value string) (time.Duration, error) {
+	match := durationPattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+	for i, unit := range units {
+		if match[i+2] == "" {
+			continue
+		}
+		n, _ := strconv.Atoi(match[i+2])
+		duration += time.Duration(n) * unit
+	}
+
+	if match[1] == "-" {
+		duration = -duration
+	}
+
+	return duration, nil
+}
+
+func unescapeText(text string) string {
+	return strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	).Replace(text)
+}
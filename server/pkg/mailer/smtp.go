@@ -0,0 +1,197 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/ali-nur31/mile-do/config"
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+//go:embed templates
+var templatesFS embed.FS
+
+const sendTimeout = 30 * time.Second
+
+// SmtpMailer sends every message as multipart email with plain text and html parts.
+// STARTTLS is used when server offers it, port 465 is dialed over implicit TLS,
+// so local stand-ins like MailHog work without any extra setup.
+type SmtpMailer struct {
+	cfg          config.Smtp
+	from         *mail.Address
+	textTemplate *texttemplate.Template
+	htmlTemplate *htmltemplate.Template
+}
+
+func NewSmtpMailer(cfg *config.Smtp) (*SmtpMailer, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse smtp sender address: %w", err)
+	}
+
+	textTemplate, err := texttemplate.ParseFS(templatesFS, "templates/*.txt")
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse plain text email templates: %w", err)
+	}
+
+	htmlTemplate, err := htmltemplate.ParseFS(templatesFS, "templates/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse html email templates: %w", err)
+	}
+
+	return &SmtpMailer{
+		cfg:          *cfg,
+		from:         from,
+		textTemplate: textTemplate,
+		htmlTemplate: htmlTemplate,
+	}, nil
+}
+
+func (m *SmtpMailer) Send(ctx context.Context, message domain.EmailMessage) error {
+	body, err := m.buildMessage(message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	client, err := m.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if m.cfg.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)); err != nil {
+			return fmt.Errorf("couldn't authenticate on smtp server: %w", err)
+		}
+	}
+
+	if err = client.Mail(m.from.Address); err != nil {
+		return fmt.Errorf("couldn't set email sender: %w", err)
+	}
+	if err = client.Rcpt(message.To); err != nil {
+		return fmt.Errorf("couldn't set email recipient: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("couldn't start email data: %w", err)
+	}
+	if _, err = writer.Write(body); err != nil {
+		return fmt.Errorf("couldn't write email data: %w", err)
+	}
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("couldn't send email: %w", err)
+	}
+
+	return client.Quit()
+}
+
+func (m *SmtpMailer) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(m.cfg.Host, m.cfg.Port)
+	tlsConfig := &tls.Config{ServerName: m.cfg.Host}
+
+	var conn net.Conn
+	var err error
+	if m.cfg.Port == "465" {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to smtp server: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("couldn't start smtp session: %w", err)
+	}
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("couldn't start tls with smtp server: %w", err)
+		}
+	}
+
+	return client, nil
+}
+
+func (m *SmtpMailer) buildMessage(message domain.EmailMessage) ([]byte, error) {
+	var text, html bytes.Buffer
+	if err := m.textTemplate.ExecuteTemplate(&text, message.Template+".txt", message.Data); err != nil {
+		return nil, fmt.Errorf("couldn't render plain text email %s: %w", message.Template, err)
+	}
+	if err := m.htmlTemplate.ExecuteTemplate(&html, message.Template+".html", message.Data); err != nil {
+		return nil, fmt.Errorf("couldn't render html email %s: %w", message.Template, err)
+	}
+
+	var buf bytes.Buffer
+	parts := multipart.NewWriter(&buf)
+
+	headers := []string{
+		"From: " + m.from.String(),
+		"To: " + (&mail.Address{Address: message.To}).String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", message.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + parts.Boundary(),
+	}
+
+	var out bytes.Buffer
+	out.WriteString(strings.Join(headers, "\r\n"))
+	out.WriteString("\r\n\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		body        []byte
+	}{
+		{contentType: "text/plain; charset=utf-8", body: text.Bytes()},
+		{contentType: "text/html; charset=utf-8", body: html.Bytes()},
+	} {
+		writer, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create email part: %w", err)
+		}
+
+		encoder := quotedprintable.NewWriter(writer)
+		if _, err = encoder.Write(part.body); err != nil {
+			return nil, fmt.Errorf("couldn't write email part: %w", err)
+		}
+		if err = encoder.Close(); err != nil {
+			return nil, fmt.Errorf("couldn't write email part: %w", err)
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, fmt.Errorf("couldn't close email parts: %w", err)
+	}
+
+	out.Write(buf.Bytes())
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,44 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+// EmailNotifier sends notification to email of user, unless user turned email notifications off.
+type EmailNotifier struct {
+	mailer      domain.Mailer
+	userService domain.UserService
+}
+
+func NewEmailNotifier(mailer domain.Mailer, userService domain.UserService) *EmailNotifier {
+	return &EmailNotifier{
+		mailer:      mailer,
+		userService: userService,
+	}
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, input domain.NotificationInput) error {
+	user, err := n.userService.GetUserByID(ctx, int64(input.UserID))
+	if err != nil {
+		return err
+	}
+
+	if !user.EmailNotifications {
+		return nil
+	}
+
+	err = n.mailer.Send(ctx, domain.EmailMessage{
+		To:       user.Email,
+		Subject:  input.Title,
+		Template: domain.EmailTemplateNotification,
+		Data:     input,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't send notification email: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+// MultiNotifier delivers notification through primary notifier and then through every secondary one.
+// Only failure of primary is returned, so a retry happens before anything was sent;
+// secondary channels are best effort, retrying them would repeat the channels that already succeeded.
+type MultiNotifier struct {
+	primary   domain.Notifier
+	secondary []domain.Notifier
+}
+
+func NewMultiNotifier(primary domain.Notifier, secondary ...domain.Notifier) *MultiNotifier {
+	return &MultiNotifier{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func (n *MultiNotifier) Notify(ctx context.Context, input domain.NotificationInput) error {
+	err := n.primary.Notify(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	for _, notifier := range n.secondary {
+		if err = notifier.Notify(ctx, input); err != nil {
+			slog.Error("couldn't deliver notification through secondary channel", "user_id", input.UserID, "task_id", input.TaskID, "error", err)
+		}
+	}
+
+	return nil
+}
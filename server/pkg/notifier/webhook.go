@@ -0,0 +1,29 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+// WebhookNotifier sends notification as reminder.due event to webhooks user subscribed to it,
+// deliveries are signed and guarded like every other webhook event.
+type WebhookNotifier struct {
+	webhookService domain.WebhookService
+}
+
+func NewWebhookNotifier(webhookService domain.WebhookService) *WebhookNotifier {
+	return &WebhookNotifier{
+		webhookService: webhookService,
+	}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, input domain.NotificationInput) error {
+	n.webhookService.DispatchEvent(ctx, input.UserID, domain.WebhookEventReminderDue, domain.WebhookReminderData{
+		TaskID: input.TaskID,
+		Title:  input.Title,
+		Body:   input.Body,
+	})
+
+	return nil
+}
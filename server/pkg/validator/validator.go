@@ -50,7 +50,7 @@ func ValidateStruct(structure interface{}) []ValidationError {
 
 func msgForTag(tag, param string) string {
 	switch tag {
-	case "required":
+	case "required", "required_if", "required_unless":
 		return "This field is required"
 	case "email":
 		return "Invalid email format"
@@ -64,6 +64,12 @@ func msgForTag(tag, param string) string {
 		return "Invalid url format"
 	case "gte":
 		return fmt.Sprintf("Must be greater than or equal: %s", param)
+	case "gt":
+		return fmt.Sprintf("Must be greater than: %s", param)
+	case "datetime":
+		return fmt.Sprintf("Invalid date format, expected: %s", param)
+	case "timezone":
+		return "Invalid IANA time zone, e.g. Asia/Almaty"
 	default:
 		return fmt.Sprintf("Failed on tag: %s", tag)
 	}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"syscall"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+// deniedPrefixes are special purpose ranges the netip predicates don't cover,
+// translation ranges are denied because they embed an IPv4 address that may be internal.
+var deniedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),  // carrier-grade NAT, used by cloud metadata and internal services
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("2001::/32"),      // Teredo
+	netip.MustParsePrefix("2002::/16"),      // 6to4
+	netip.MustParsePrefix("100::/64"),       // discard-only
+}
+
+// isPublicAddr tells whether webhooks may be delivered to addr, internal networks of the server are off limits.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range deniedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified()
+}
+
+// controlDial runs after DNS resolution on every connection, so a host re-resolving
+// to an internal address between ValidateUrl and delivery is refused as well.
+func controlDial(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse webhook address %q: %w", address, err)
+	}
+
+	if !isPublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", domain.InvalidWebhookUrlError, addrPort.Addr())
+	}
+
+	return nil
+}
+
+// ValidateUrl refuses webhook url whose host resolves to an internal address.
+func (s *HttpSender) ValidateUrl(ctx context.Context, rawUrl string) error {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Hostname() == "" {
+		return domain.InvalidWebhookUrlError
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", parsedUrl.Hostname())
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("%w: couldn't resolve host %q", domain.InvalidWebhookUrlError, parsedUrl.Hostname())
+	}
+
+	for _, addr := range addrs {
+		if !isPublicAddr(addr) {
+			return fmt.Errorf("%w: host %q resolves to internal address", domain.InvalidWebhookUrlError, parsedUrl.Hostname())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		name   string
+		addr   string
+		public bool
+	}{
+		{name: "public ipv4", addr: "93.184.216.34", public: true},
+		{name: "public ipv6", addr: "2606:4700:4700::1111", public: true},
+		{name: "loopback", addr: "127.0.0.1"},
+		{name: "private", addr: "10.1.2.3"},
+		{name: "link-local", addr: "169.254.169.254"},
+		{name: "unspecified", addr: "0.0.0.0"},
+		{name: "this network", addr: "0.1.2.3"},
+		{name: "carrier-grade nat", addr: "100.100.100.200"},
+		{name: "carrier-grade nat mapped to ipv6", addr: "::ffff:100.64.0.1"},
+		{name: "ietf protocol assignments", addr: "192.0.0.170"},
+		{name: "benchmarking", addr: "198.19.255.254"},
+		{name: "reserved", addr: "240.0.0.1"},
+		{name: "broadcast", addr: "255.255.255.255"},
+		{name: "nat64", addr: "64:ff9b::a00:1"},
+		{name: "local-use nat64", addr: "64:ff9b:1::a00:1"},
+		{name: "teredo", addr: "2001:0:4136:e378:8000:63bf:3fff:fdd2"},
+		{name: "6to4", addr: "2002:a00:1::1"},
+		{name: "discard-only", addr: "100::1"},
+		{name: "ipv6 loopback", addr: "::1"},
+		{name: "ipv6 unique local", addr: "fd00::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isPublicAddr(netip.MustParseAddr(tt.addr)); got != tt.public {
+				t.Errorf("isPublicAddr(%s) = %v, want %v", tt.addr, got, tt.public)
+			}
+		})
+	}
+}
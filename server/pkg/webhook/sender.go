@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ali-nur31/mile-do/internal/domain"
+)
+
+const (
+	EventHeader     = "X-Mile-Do-Event"
+	DeliveryHeader  = "X-Mile-Do-Delivery"
+	SignatureHeader = "X-Mile-Do-Signature"
+)
+
+// HttpSender posts webhook body signed with HMAC-SHA256 of secret,
+// receivers verify it by comparing SignatureHeader with Sign of the raw body.
+type HttpSender struct {
+	client *http.Client
+}
+
+// NewHttpSender creates sender that never follows redirects and only dials public addresses.
+func NewHttpSender() *HttpSender {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: controlDial,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HttpSender{
+		client: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *HttpSender) Send(ctx context.Context, request domain.WebhookRequest) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.Url, bytes.NewReader(request.Body))
+	if err != nil {
+		return 0, fmt.Errorf("couldn't create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Mile-Do-Webhook")
+	req.Header.Set(EventHeader, request.Event)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(request.DeliveryID, 10))
+	req.Header.Set(SignatureHeader, Sign(request.Secret, request.Body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns signature of body in form "sha256=<hex digest>".
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}